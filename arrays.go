@@ -0,0 +1,89 @@
+package magento
+
+import "encoding/xml"
+
+// SOAP arrays are encoded as a list of <item> elements. These types are used
+// instead of an `xml:"name>item"` tag for optional arrays: encoding/xml writes
+// the parent element of an empty slice anyway, which Magento doesn't see as an
+// empty array.
+
+type AssociativeArray []AssociativeEntity
+
+func (a AssociativeArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalItems(e, start, []AssociativeEntity(a))
+}
+
+func (a *AssociativeArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := struct {
+		Items []AssociativeEntity `xml:"item"`
+	}{}
+	err := d.DecodeElement(&v, &start)
+	*a = v.Items
+	return err
+}
+
+type ComplexFilterArray []ComplexFilter
+
+func (a ComplexFilterArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalItems(e, start, []ComplexFilter(a))
+}
+
+func (a *ComplexFilterArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := struct {
+		Items []ComplexFilter `xml:"item"`
+	}{}
+	err := d.DecodeElement(&v, &start)
+	*a = v.Items
+	return err
+}
+
+type ArrayOfString []string
+
+func (a ArrayOfString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalItems(e, start, []string(a))
+}
+
+func (a *ArrayOfString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := struct {
+		Items []string `xml:"item"`
+	}{}
+	err := d.DecodeElement(&v, &start)
+	*a = v.Items
+	return err
+}
+
+type OrderItemIDQtyArray []OrderItemIDQty
+
+func (a OrderItemIDQtyArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalItems(e, start, []OrderItemIDQty(a))
+}
+
+func (a *OrderItemIDQtyArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := struct {
+		Items []OrderItemIDQty `xml:"item"`
+	}{}
+	err := d.DecodeElement(&v, &start)
+	*a = v.Items
+	return err
+}
+
+type SalesOrderCreditmemoItemQtyArray []SalesOrderCreditmemoItemQty
+
+func (a SalesOrderCreditmemoItemQtyArray) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalItems(e, start, []SalesOrderCreditmemoItemQty(a))
+}
+
+func (a *SalesOrderCreditmemoItemQtyArray) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := struct {
+		Items []SalesOrderCreditmemoItemQty `xml:"item"`
+	}{}
+	err := d.DecodeElement(&v, &start)
+	*a = v.Items
+	return err
+}
+
+func marshalItems(e *xml.Encoder, start xml.StartElement, items interface{}) error {
+	return e.EncodeElement(struct {
+		Items interface{} `xml:"item"`
+	}{items}, start)
+}
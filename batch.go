@@ -0,0 +1,319 @@
+package magento
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	multiCallAction = "multiCall"
+
+	defaultBatchSize        = 100
+	defaultBatchConcurrency = 4
+)
+
+// ErrNotExecuted is set on the operations of a batch that weren't executed
+// because an earlier operation failed and FailOnError is set
+var ErrNotExecuted = errors.New("magento: operation not executed")
+
+// Batch bundles operations to cut the number of round trips. In SOAP v1 mode
+// the operations are sent in multiCall requests of Size operations; in SOAP
+// v2 mode, which has no multiCall, Concurrency requests are sent in parallel.
+//
+//	batch := magento.NewBatch(client)
+//	responses := make([]*magento.CatalogProductInfoResponse, len(skus))
+//	operations := make([]*magento.BatchOperation, len(skus))
+//	for i, sku := range skus {
+//		req := magento.NewCatalogProductInfoRequest()
+//		req.Product = sku
+//		req.IdentifierType = "sku"
+//		responses[i], operations[i] = client.CatalogProduct.InfoBatch(batch, req)
+//	}
+//	err := batch.Run(ctx)
+//
+// Run only returns request errors (and with FailOnError the first failing
+// operation); the error of every operation is set on its BatchOperation.
+type Batch struct {
+	Client *Client
+
+	// Stop at the first failing operation. In SOAP v1 mode it's sent as the
+	// "break" option of multiCall; without it Magento executes all operations
+	// of the request and returns the faults in between the results. The
+	// operations after the first fault get ErrNotExecuted.
+	FailOnError bool
+
+	// Maximum number of operations per multiCall request
+	Size int
+
+	// Number of parallel SOAP v2 requests
+	Concurrency int
+
+	operations []*BatchOperation
+}
+
+func NewBatch(client *Client) *Batch {
+	return &Batch{
+		Client:      client,
+		Size:        defaultBatchSize,
+		Concurrency: defaultBatchConcurrency,
+	}
+}
+
+type BatchOperation struct {
+	// SOAP v1 resource path and arguments
+	ResourcePath string
+	Args         []interface{}
+
+	// Error of the operation; faults returned by multiCall are a *BatchFault
+	Err error
+
+	// result the SOAP v1 return value is decoded into
+	result interface{}
+
+	// call executes the operation as a single SOAP v2 request
+	call func(context.Context) error
+
+	// check validates the operation before it's sent in a multiCall request
+	// (e.g. the store view); the SOAP v2 call does its own checks
+	check func(context.Context) error
+}
+
+// BatchFault is the fault of a single operation of a multiCall request
+type BatchFault struct {
+	Code    string
+	Message string
+}
+
+func (f *BatchFault) Error() string {
+	return fmt.Sprintf("%s (%s)", f.Message, f.Code)
+}
+
+// Add adds a SOAP v1 resource call, see Client.CallV1(). It can't be run in
+// SOAP v2 mode; use the Batch methods of the services (e.g.
+// CatalogProductService.InfoBatch()) for operations that should work in both
+// modes.
+func (b *Batch) Add(resourcePath string, args []interface{}, result interface{}) *BatchOperation {
+	return b.add(resourcePath, args, result, nil, nil)
+}
+
+func (b *Batch) add(resourcePath string, args []interface{}, result interface{}, call func(context.Context) error, check func(context.Context) error) *BatchOperation {
+	if args == nil {
+		args = []interface{}{}
+	}
+
+	operation := &BatchOperation{
+		ResourcePath: resourcePath,
+		Args:         args,
+		result:       result,
+		call:         call,
+		check:        check,
+	}
+	b.operations = append(b.operations, operation)
+	return operation
+}
+
+func (b *Batch) Operations() []*BatchOperation {
+	return b.operations
+}
+
+// Run executes all operations of the batch
+func (b *Batch) Run(ctx context.Context) error {
+	if b.Client.IsSoapV1() {
+		return b.runV1(ctx)
+	}
+	return b.runV2(ctx)
+}
+
+func (b *Batch) runV1(ctx context.Context) error {
+	size := b.Size
+	if size <= 0 {
+		size = defaultBatchSize
+	}
+
+	// operations that fail their check aren't sent
+	operations := []*BatchOperation{}
+	for _, operation := range b.operations {
+		operation.Err = nil
+		if operation.check != nil {
+			operation.Err = operation.check(ctx)
+		}
+		if operation.Err == nil {
+			operations = append(operations, operation)
+			continue
+		}
+
+		if b.FailOnError {
+			for _, other := range b.operations {
+				if other != operation {
+					other.Err = ErrNotExecuted
+				}
+			}
+			return operation.Err
+		}
+	}
+
+	for start := 0; start < len(operations); start += size {
+		end := start + size
+		if end > len(operations) {
+			end = len(operations)
+		}
+
+		err := b.multiCall(ctx, operations[start:end])
+		if err == nil {
+			continue
+		}
+
+		for _, operation := range operations[end:] {
+			operation.Err = ErrNotExecuted
+		}
+		return err
+	}
+
+	return nil
+}
+
+// multiCall sends the operations in one multiCall request. It returns an
+// error when the request fails or, with FailOnError, an operation fails.
+func (b *Batch) multiCall(ctx context.Context, operations []*BatchOperation) error {
+	value, err := b.Client.doV1(ctx, multiCallAction, b.multiCallParams(operations))
+	if err != nil {
+		for _, operation := range operations {
+			operation.Err = err
+		}
+		return err
+	}
+
+	results, ok := value.([]interface{})
+	if !ok {
+		err := fmt.Errorf("Expected an array of multiCall results, got %T", value)
+		for _, operation := range operations {
+			operation.Err = err
+		}
+		return err
+	}
+
+	var firstErr error
+	for i, operation := range operations {
+		if i >= len(results) {
+			operation.Err = ErrNotExecuted
+			continue
+		}
+
+		fault := multiCallFault(results[i])
+		if fault != nil {
+			operation.Err = fault
+		} else {
+			operation.Err = setV1Result(results[i], operation.result)
+		}
+
+		if firstErr == nil && operation.Err != nil {
+			firstErr = operation.Err
+		}
+	}
+
+	if b.FailOnError {
+		return firstErr
+	}
+	return nil
+}
+
+// multiCallParams returns the calls and options of a multiCall request
+func (b *Batch) multiCallParams(operations []*BatchOperation) Params {
+	calls := make([]interface{}, len(operations))
+	for i, operation := range operations {
+		calls[i] = []interface{}{operation.ResourcePath, operation.Args}
+	}
+
+	return Params{
+		{"calls", calls},
+		{"options", map[string]interface{}{"break": b.FailOnError}},
+	}
+}
+
+// multiCallFault returns the fault of a multiCall result:
+// {"isFault": true, "faultCode": "...", "faultMessage": "..."}
+func multiCallFault(result interface{}) *BatchFault {
+	m, ok := result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	isFault, _ := m["isFault"].(string)
+	if isFault != "true" && isFault != "1" {
+		return nil
+	}
+
+	code, _ := m["faultCode"].(string)
+	message, _ := m["faultMessage"].(string)
+	return &BatchFault{Code: code, Message: message}
+}
+
+func (b *Batch) runV2(ctx context.Context) error {
+	concurrency := b.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	// log in once instead of in every goroutine
+	_, err := b.Client.GetSession(ctx)
+	if err != nil {
+		for _, operation := range b.operations {
+			operation.Err = err
+		}
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mutex sync.Mutex
+	var firstErr error
+	failed := false
+
+	operations := make(chan *BatchOperation)
+	wg := sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for operation := range operations {
+				mutex.Lock()
+				skip := failed
+				mutex.Unlock()
+				if skip {
+					operation.Err = ErrNotExecuted
+					continue
+				}
+
+				if operation.call == nil {
+					operation.Err = fmt.Errorf("%s is only available in SOAP v1 mode", operation.ResourcePath)
+				} else {
+					operation.Err = operation.call(ctx)
+				}
+
+				if operation.Err != nil && b.FailOnError {
+					mutex.Lock()
+					if !failed {
+						failed = true
+						firstErr = operation.Err
+						cancel()
+					} else if errors.Is(operation.Err, context.Canceled) {
+						// canceled because an other operation failed
+						operation.Err = ErrNotExecuted
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, operation := range b.operations {
+		operations <- operation
+	}
+	close(operations)
+	wg.Wait()
+
+	return firstErr
+}
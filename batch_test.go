@@ -0,0 +1,118 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBatchMultiCallOptions(t *testing.T) {
+	for _, failOnError := range []bool{true, false} {
+		batch := NewBatch(NewClient(nil))
+		batch.FailOnError = failOnError
+		batch.Add(catalogProductInfoResource, []interface{}{"abc"}, nil)
+
+		requestBody := &v1Request{
+			XMLName:   xml.Name{Space: xmlns, Local: multiCallAction},
+			SessionID: &Session{},
+			Params:    batch.multiCallParams(batch.Operations()),
+		}
+		data, err := xml.Marshal(requestBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := `<options xsi:type="ns2:Map"><item><key xsi:type="xsd:string">break</key><value xsi:type="xsd:boolean">false</value></item></options>`
+		if failOnError {
+			want = strings.Replace(want, "false", "true", 1)
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s doesn't contain %s", data, want)
+		}
+	}
+}
+
+func TestBatchInfoStoreView(t *testing.T) {
+	server := newTestServer(t, func(operation string, body []byte) (int, string) {
+		return 0, `<ns1:multiCallResponse><multiCallReturn xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:SOAP-ENC="http://schemas.xmlsoap.org/soap/encoding/" xmlns:ns2="http://xml.apache.org/xml-soap" SOAP-ENC:arrayType="xsd:ur-type[1]" xsi:type="SOAP-ENC:Array"><item xsi:type="ns2:Map"><item><key xsi:type="xsd:string">product_id</key><value xsi:type="xsd:string">1</value></item></item></multiCallReturn></ns1:multiCallResponse>`
+	})
+	c := server.client(WithSoapVersion(SoapV1))
+	c.SetValidateStoreView(true)
+	c.stores = map[string]int{"default": 1, "1": 1}
+
+	batch := NewBatch(c)
+	valid := NewCatalogProductInfoRequest()
+	valid.Product = "abc"
+	valid.StoreView = "default"
+	responseBody, validOperation := c.CatalogProduct.InfoBatch(batch, valid)
+	invalid := NewCatalogProductInfoRequest()
+	invalid.Product = "def"
+	invalid.StoreView = "nl"
+	_, invalidOperation := c.CatalogProduct.InfoBatch(batch, invalid)
+
+	err := batch.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if validOperation.Err != nil || responseBody.Info.ProductID != "1" {
+		t.Errorf("got %v, %+v", validOperation.Err, responseBody.Info)
+	}
+	if invalidOperation.Err == nil {
+		t.Error("expected an error for an unknown store view")
+	}
+
+	bodies := server.requestBodies()
+	if len(bodies) != 1 || strings.Contains(string(bodies[0]), ">def<") {
+		t.Errorf("the operation with an unknown store view is sent: %s", bodies)
+	}
+
+	// with FailOnError nothing is sent
+	batch.FailOnError = true
+	err = batch.Run(context.Background())
+	if err == nil || validOperation.Err != ErrNotExecuted {
+		t.Errorf("got %v and %v, want a store view error and ErrNotExecuted", err, validOperation.Err)
+	}
+	if n := len(server.requestBodies()); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+func TestBatchFailOnErrorV2(t *testing.T) {
+	slowDone := make(chan struct{})
+	defer close(slowDone)
+	server := newTestServer(t, func(operation string, body []byte) (int, string) {
+		if strings.Contains(string(body), "<product>slow</product>") {
+			// only answered after the client has given up
+			select {
+			case <-slowDone:
+			case <-time.After(5 * time.Second):
+			}
+		}
+		return http.StatusInternalServerError, soapFault("101", "Product not exists.")
+	})
+	c := server.client()
+
+	batch := NewBatch(c)
+	batch.FailOnError = true
+	batch.Concurrency = 2
+
+	slow := NewCatalogProductInfoRequest()
+	slow.Product = "slow"
+	_, slowOperation := c.CatalogProduct.InfoBatch(batch, slow)
+	failing := NewCatalogProductInfoRequest()
+	failing.Product = "abc"
+	_, failingOperation := c.CatalogProduct.InfoBatch(batch, failing)
+
+	err := batch.Run(context.Background())
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) || failingOperation.Err != err {
+		t.Errorf("got %v, want the fault of the failing operation", err)
+	}
+	if slowOperation.Err != ErrNotExecuted {
+		t.Errorf("got %v for the canceled operation, want ErrNotExecuted", slowOperation.Err)
+	}
+}
@@ -0,0 +1,323 @@
+package magento
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"reflect"
+	"sort"
+)
+
+// Param is a named parameter of a generic call
+type Param struct {
+	Name  string
+	Value interface{}
+}
+
+// Params are sent in the order they are defined
+type Params []Param
+
+// Call calls any SOAP v2 operation, for example one that is added by a
+// third-party extension. The session ID is sent as the first parameter.
+// Failed calls are not retried, see CallIdempotent().
+//
+// params can be nil, Params, a map[string]interface{} (sent with sorted
+// keys) or a struct whose fields are sent as parameters. Nested maps and
+// Params are sent as elements; slices are sent as SOAP arrays of <item>
+// elements.
+//
+// result can be nil to ignore the response, a pointer to a struct the
+// response element is decoded into (like the *Response types of the
+// services), a *Node or a *map[string]interface{} (see Node.Map()).
+//
+//	var result map[string]interface{}
+//	params := magento.Params{{"orderIncrementId", "100000001"}}
+//	err := client.Call(ctx, "customOrderExport", params, &result)
+func (c *Client) Call(ctx context.Context, operation string, params interface{}, result interface{}) error {
+	return c.call(ctx, operation, params, result, false)
+}
+
+// CallIdempotent calls an operation like Call(), for operations that only
+// read data: failed calls are retried according to the RetryPolicy
+func (c *Client) CallIdempotent(ctx context.Context, operation string, params interface{}, result interface{}) error {
+	return c.call(ctx, operation, params, result, true)
+}
+
+func (c *Client) call(ctx context.Context, operation string, params interface{}, result interface{}, idempotent bool) error {
+	requestBody := &callRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: operation,
+		},
+		Params: params,
+		retry:  idempotent,
+	}
+	session, err := c.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := c.NewRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	node := &Node{}
+	responseBody := result
+	m, isMap := result.(*map[string]interface{})
+	if result == nil || isMap {
+		responseBody = node
+	}
+	response := NewResponse().WithData(responseBody)
+
+	// submit the request
+	_, err = c.Do(httpReq, response)
+	if err != nil {
+		return err
+	}
+
+	if isMap {
+		*m = node.Map()
+	}
+	return nil
+}
+
+type callRequest struct {
+	XMLName xml.Name
+
+	SessionID *Session
+	Params    interface{}
+
+	// retried by the RetryPolicy, see CallIdempotent()
+	retry bool
+}
+
+func (r *callRequest) idempotent() bool {
+	return r.retry
+}
+
+func (r *callRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start = xml.StartElement{Name: r.XMLName}
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	err = e.EncodeElement(r.SessionID, xml.StartElement{Name: xml.Name{Local: "sessionId"}})
+	if err != nil {
+		return err
+	}
+
+	err = encodeParams(e, r.Params)
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// encodeParams encodes params as a list of sibling elements
+func encodeParams(e *xml.Encoder, params interface{}) error {
+	switch p := params.(type) {
+	case nil:
+		return nil
+	case Params:
+		for _, param := range p {
+			err := encodeParam(e, param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case []Param:
+		return encodeParams(e, Params(p))
+	case map[string]interface{}:
+		keys := make([]string, 0, len(p))
+		for key := range p {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			err := encodeParam(e, key, p[key])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return encodeStructFields(e, params)
+}
+
+func encodeParam(e *xml.Encoder, name string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	switch value.(type) {
+	case Params, []Param, map[string]interface{}:
+		err := e.EncodeToken(start)
+		if err != nil {
+			return err
+		}
+
+		err = encodeParams(e, value)
+		if err != nil {
+			return err
+		}
+
+		return e.EncodeToken(start.End())
+	}
+
+	// types with their own marshaller (e.g. ArrayOfString) know best
+	if _, ok := value.(xml.Marshaler); ok {
+		return e.EncodeElement(value, start)
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		err := e.EncodeToken(start)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			err := encodeParam(e, "item", v.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+		}
+
+		return e.EncodeToken(start.End())
+	}
+
+	return e.EncodeElement(value, start)
+}
+
+// encodeStructFields encodes the fields of a struct without its enclosing
+// element by marshalling the struct and copying the inner tokens
+func encodeStructFields(e *xml.Encoder, params interface{}) error {
+	data, err := xml.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(data))
+	depth := 0
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case xml.EndElement:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+
+		err = e.EncodeToken(xml.CopyToken(token))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Node is a generic XML tree used to decode responses without a predefined
+// struct
+type Node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",chardata"`
+	Nodes   []Node     `xml:",any"`
+}
+
+// Map returns the child elements of the node as a map of element names to
+// values, see Value(). Child elements that occur more than once are
+// returned as a []interface{}.
+func (n Node) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(n.Nodes))
+	for _, child := range n.Nodes {
+		name := child.XMLName.Local
+		if n.countChildren(name) > 1 {
+			list, _ := m[name].([]interface{})
+			m[name] = append(list, child.Value())
+			continue
+		}
+
+		m[name] = child.Value()
+	}
+	return m
+}
+
+// Value converts the node to a Go value:
+//   - nil for elements with xsi:nil="true"
+//   - []interface{} for SOAP arrays (elements with an arrayType attribute or
+//     with only <item> children)
+//   - map[string]interface{} for other elements with children
+//   - string for other elements
+func (n Node) Value() interface{} {
+	if n.isNil() {
+		return nil
+	}
+
+	if n.isArray() {
+		list := make([]interface{}, len(n.Nodes))
+		for i, child := range n.Nodes {
+			list[i] = child.Value()
+		}
+		return list
+	}
+
+	if len(n.Nodes) > 0 {
+		return n.Map()
+	}
+
+	return n.Content
+}
+
+func (n Node) isNil() bool {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func (n Node) isArray() bool {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == "arrayType" {
+			return true
+		}
+	}
+
+	if len(n.Nodes) == 0 {
+		return false
+	}
+
+	return n.countChildren("item") == len(n.Nodes)
+}
+
+func (n Node) countChildren(name string) int {
+	count := 0
+	for _, child := range n.Nodes {
+		if child.XMLName.Local == name {
+			count++
+		}
+	}
+	return count
+}
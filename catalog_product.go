@@ -3,6 +3,7 @@ package magento
 import (
 	"context"
 	"encoding/xml"
+	"iter"
 	"time"
 
 	"github.com/aodin/date"
@@ -25,10 +26,18 @@ type CatalogProductService struct {
 	Client *Client
 }
 
-func (s *CatalogProductService) List(requestBody *CatalogProductListRequest, ctx context.Context) (*CatalogProductListResponse, error) {
+func (s *CatalogProductService) List(ctx context.Context, requestBody *CatalogProductListRequest) (*CatalogProductListResponse, error) {
+	if s.Client.IsSoapV1() {
+		return s.listV1(ctx, requestBody)
+	}
+
 	responseBody := NewCatalogProductListResponse()
 	response := NewResponse().WithData(responseBody)
-	requestBody.SessionID = s.Client.GetSession()
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
 	request := NewRequest().WithData(requestBody)
 
 	// create a new HTTP request
@@ -42,6 +51,63 @@ func (s *CatalogProductService) List(requestBody *CatalogProductListRequest, ctx
 	return responseBody, err
 }
 
+// ListStream lists the products like List(), but passes every product to fn
+// while the response is read instead of keeping the whole list in memory. fn
+// can return ErrStopStream to stop early. In SOAP v1 mode the
+// list is read completely first.
+func (s *CatalogProductService) ListStream(ctx context.Context, requestBody *CatalogProductListRequest, fn func(CatalogProductEntity) error) error {
+	// SOAP v1 can't be streamed
+	if s.Client.IsSoapV1() {
+		responseBody, err := s.listV1(ctx, requestBody)
+		if err != nil {
+			return err
+		}
+		return forEachItem(responseBody.StoreView.Items, fn)
+	}
+
+	response := NewResponse().WithStream(streamItems(fn))
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return err
+}
+
+// ListAll lists all products matching the filters in pages of IDs, see
+// WithPageSize() and WithStartAfter():
+//
+//	for product, err := range client.CatalogProduct.ListAll(ctx, filters) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Only one page is kept in memory.
+func (s *CatalogProductService) ListAll(ctx context.Context, filters *Filters, opts ...PageOption) iter.Seq2[CatalogProductEntity, error] {
+	config := newPageConfig(catalogProductPageKey, opts)
+	id := func(product CatalogProductEntity) int {
+		return product.ProductID
+	}
+	list := func(ctx context.Context, filters *Filters, fn func(CatalogProductEntity) error) error {
+		requestBody := NewCatalogProductListRequest()
+		requestBody.Filters = filters
+		return s.ListStream(ctx, requestBody, fn)
+	}
+	return listAll(ctx, filters, config, id, list)
+}
+
 func NewCatalogProductListRequest() *CatalogProductListRequest {
 	return &CatalogProductListRequest{
 		XMLName: xml.Name{
@@ -55,7 +121,7 @@ type CatalogProductListRequest struct {
 	XMLName xml.Name `xml:"catalogProductList"`
 
 	SessionID *Session
-	Filters   *Filters `xml:filters,omitempty`
+	Filters   *Filters `xml:"filters,omitempty"`
 	StoreView string   `xml:"storeView,omitempty"`
 }
 
@@ -85,10 +151,28 @@ type CatalogProductEntity struct {
 	WebsiteIDs  []int  `xml:"website_ids>item"`
 }
 
-func (s *CatalogProductService) Create(requestBody *CatalogProductCreateRequest, ctx context.Context) (*CatalogProductCreateResponse, error) {
+func (s *CatalogProductService) Create(ctx context.Context, requestBody *CatalogProductCreateRequest) (*CatalogProductCreateResponse, error) {
+	product, err := s.Client.productWithGroupIDs(ctx, requestBody.ProductData)
+	if err != nil {
+		return nil, err
+	}
+	if product != requestBody.ProductData {
+		resolved := *requestBody
+		resolved.ProductData = product
+		requestBody = &resolved
+	}
+
+	if s.Client.IsSoapV1() {
+		return s.createV1(ctx, requestBody)
+	}
+
 	responseBody := NewCatalogProductCreateResponse()
 	response := NewResponse().WithData(responseBody)
-	requestBody.SessionID = s.Client.GetSession()
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
 	request := NewRequest().WithData(requestBody)
 
 	// create a new HTTP request
@@ -148,8 +232,8 @@ type CatalogProductCreateEntity struct {
 	WebsiteIDs           []string                                   `xml:"website_ids"`
 	HasOptions           bool                                       `xml:"has_options"`
 	GiftMessageAvailable bool                                       `xml:"gist_message_available"`
-	Price                float64                                    `xml:"price"`
-	SpecialPrice         float64                                    `xml:"special_price"`
+	Price                Decimal                                    `xml:"price,omitempty"`
+	SpecialPrice         Decimal                                    `xml:"special_price,omitempty"`
 	SpecialFromDate      date.Date                                  `xml:"special_from_date"`
 	SpecialToDate        date.Date                                  `xml:"special_to_date"`
 	TaxClassID           int                                        `xml:"tax_class_id"`
@@ -165,10 +249,12 @@ type CatalogProductCreateEntity struct {
 }
 
 type CatalogProductTierPriceEntity struct {
+	// Customer group ID, "all" or a customer group code like "Wholesale",
+	// which Create and Update resolve to its ID
 	CustomerGroupID string  `xml:"customer_group_id"`
 	Website         string  `xml:"website"`
 	Qty             int     `xml:"qty"`
-	Price           float64 `xml:"price"`
+	Price           Decimal `xml:"price"`
 }
 
 type CatalogProductAdditionalAttributesEntity struct {
@@ -202,10 +288,28 @@ type CatalogInventoryStockItemUpdateEntity struct {
 	UseConfigNotifyStockQty bool `xml:"use_config_notify_stock_qty"`
 }
 
-func (s *CatalogProductService) Update(requestBody *CatalogProductUpdateRequest, ctx context.Context) (*CatalogProductUpdateResponse, error) {
+func (s *CatalogProductService) Update(ctx context.Context, requestBody *CatalogProductUpdateRequest) (*CatalogProductUpdateResponse, error) {
+	product, err := s.Client.productWithGroupIDs(ctx, requestBody.ProductData)
+	if err != nil {
+		return nil, err
+	}
+	if product != requestBody.ProductData {
+		resolved := *requestBody
+		resolved.ProductData = product
+		requestBody = &resolved
+	}
+
+	if s.Client.IsSoapV1() {
+		return s.updateV1(ctx, requestBody)
+	}
+
 	responseBody := NewCatalogProductUpdateResponse()
 	response := NewResponse().WithData(responseBody)
-	requestBody.SessionID = s.Client.GetSession()
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
 	request := NewRequest().WithData(requestBody)
 
 	// create a new HTTP request
@@ -249,10 +353,18 @@ type CatalogProductUpdateResponse struct {
 
 type IdentifierType string
 
-func (s *CatalogProductService) Info(requestBody *CatalogProductInfoRequest, ctx context.Context) (*CatalogProductInfoResponse, error) {
+func (s *CatalogProductService) Info(ctx context.Context, requestBody *CatalogProductInfoRequest) (*CatalogProductInfoResponse, error) {
+	if s.Client.IsSoapV1() {
+		return s.infoV1(ctx, requestBody)
+	}
+
 	responseBody := NewCatalogProductInfoResponse()
 	response := NewResponse().WithData(responseBody)
-	requestBody.SessionID = s.Client.GetSession()
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
 	request := NewRequest().WithData(requestBody)
 
 	// create a new HTTP request
@@ -266,6 +378,27 @@ func (s *CatalogProductService) Info(requestBody *CatalogProductInfoRequest, ctx
 	return responseBody, err
 }
 
+// InfoBatch adds the request to the batch. The response is filled in when the
+// batch is run and the operation holds the error.
+func (s *CatalogProductService) InfoBatch(batch *Batch, requestBody *CatalogProductInfoRequest) (*CatalogProductInfoResponse, *BatchOperation) {
+	responseBody := NewCatalogProductInfoResponse()
+	call := func(ctx context.Context) error {
+		resp, err := s.Info(ctx, requestBody)
+		if resp != nil {
+			*responseBody = *resp
+		}
+		return err
+	}
+
+	storeView := s.Client.defaultStoreView(requestBody.StoreView)
+	check := func(ctx context.Context) error {
+		return s.Client.CheckStoreView(ctx, storeView)
+	}
+
+	operation := batch.add(catalogProductInfoResource, infoV1Args(requestBody, storeView), &responseBody.Info, call, check)
+	return responseBody, operation
+}
+
 func NewCatalogProductInfoRequest() *CatalogProductInfoRequest {
 	return &CatalogProductInfoRequest{
 		XMLName: xml.Name{
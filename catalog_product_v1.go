@@ -0,0 +1,107 @@
+package magento
+
+import "context"
+
+// SOAP v1 resource paths
+const (
+	catalogProductListResource   = "catalog_product.list"
+	catalogProductCreateResource = "catalog_product.create"
+	catalogProductUpdateResource = "catalog_product.update"
+	catalogProductInfoResource   = "catalog_product.info"
+)
+
+func (s *CatalogProductService) listV1(ctx context.Context, requestBody *CatalogProductListRequest) (*CatalogProductListResponse, error) {
+	// the v1 arguments don't get the default store view and aren't checked
+	// by NewRequest()
+	storeView := s.Client.defaultStoreView(requestBody.StoreView)
+	err := s.Client.CheckStoreView(ctx, storeView)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody := NewCatalogProductListResponse()
+	args := []interface{}{
+		v1Filters(requestBody.Filters),
+		v1Optional(storeView),
+	}
+	err = s.Client.CallV1(ctx, catalogProductListResource, args, &responseBody.StoreView)
+	return responseBody, err
+}
+
+func (s *CatalogProductService) createV1(ctx context.Context, requestBody *CatalogProductCreateRequest) (*CatalogProductCreateResponse, error) {
+	storeView := s.Client.defaultStoreView(requestBody.StoreView)
+	err := s.Client.CheckStoreView(ctx, storeView)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody := NewCatalogProductCreateResponse()
+	args := []interface{}{
+		requestBody.Type,
+		requestBody.Set,
+		requestBody.Sku,
+		requestBody.ProductData,
+		v1Optional(storeView),
+	}
+	err = s.Client.CallV1(ctx, catalogProductCreateResource, args, &responseBody.Result)
+	return responseBody, err
+}
+
+func (s *CatalogProductService) updateV1(ctx context.Context, requestBody *CatalogProductUpdateRequest) (*CatalogProductUpdateResponse, error) {
+	storeView := s.Client.defaultStoreView(requestBody.StoreView)
+	err := s.Client.CheckStoreView(ctx, storeView)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody := NewCatalogProductUpdateResponse()
+	product := requestBody.Product
+	if product == "" {
+		product = requestBody.ProductID
+	}
+	args := []interface{}{
+		product,
+		requestBody.ProductData,
+		v1Optional(storeView),
+		v1Optional(string(requestBody.IdentifierType)),
+	}
+	err = s.Client.CallV1(ctx, catalogProductUpdateResource, args, &responseBody.Result)
+	return responseBody, err
+}
+
+func (s *CatalogProductService) infoV1(ctx context.Context, requestBody *CatalogProductInfoRequest) (*CatalogProductInfoResponse, error) {
+	storeView := s.Client.defaultStoreView(requestBody.StoreView)
+	err := s.Client.CheckStoreView(ctx, storeView)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody := NewCatalogProductInfoResponse()
+	err = s.Client.CallV1(ctx, catalogProductInfoResource, infoV1Args(requestBody, storeView), &responseBody.Info)
+	return responseBody, err
+}
+
+func infoV1Args(requestBody *CatalogProductInfoRequest, storeView string) []interface{} {
+	product := requestBody.Product
+	if product == "" {
+		product = requestBody.ProductID
+	}
+
+	// SOAP v1 takes a single list of attribute codes
+	var attributes interface{}
+	codes := []string{}
+	for _, a := range requestBody.Attributes {
+		codes = append(codes, a.Attributes...)
+		codes = append(codes, a.AdditionalAttributes...)
+	}
+	if len(codes) > 0 {
+		attributes = codes
+	}
+
+	return []interface{}{
+		product,
+		v1Optional(storeView),
+		attributes,
+		v1Optional(string(requestBody.IdentifierType)),
+	}
+}
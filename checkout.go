@@ -0,0 +1,418 @@
+package magento
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Checkout places an order through the shopping cart API: it creates a quote,
+// sets the customer, addresses, products, coupon, shipping and payment method
+// and converts the quote into an order.
+//
+//	orderID, err := magento.NewCheckout(client).
+//		WithCustomer(magento.ShoppingCartCustomerEntity{Mode: magento.CheckoutModeGuest, ...}).
+//		WithBillingAddress(address).
+//		AddProduct(magento.ShoppingCartProductEntity{Sku: "abc", Qty: "1"}).
+//		WithShippingMethod("flatrate_flatrate").
+//		WithPaymentMethod(magento.ShoppingCartPaymentMethodEntity{Method: "checkmo"}).
+//		Place(ctx)
+//
+// Magento has no call to delete a quote. When a step fails the returned
+// CheckoutError holds the ID of the quote so the checkout can be retried on
+// the same quote with WithQuoteID() instead of creating a new one.
+type Checkout struct {
+	client *Client
+
+	quoteID         int
+	storeID         string
+	customer        *ShoppingCartCustomerEntity
+	billingAddress  *ShoppingCartCustomerAddressEntity
+	shippingAddress *ShoppingCartCustomerAddressEntity
+	products        []ShoppingCartProductEntity
+	couponCode      string
+	shippingMethod  string
+	paymentMethod   *ShoppingCartPaymentMethodEntity
+	licenses        []string
+}
+
+func NewCheckout(client *Client) *Checkout {
+	return &Checkout{client: client}
+}
+
+// WithQuoteID continues the checkout on an existing quote instead of creating
+// a new one. The products are only added when the quote is still empty.
+func (c *Checkout) WithQuoteID(quoteID int) *Checkout {
+	c.quoteID = quoteID
+	return c
+}
+
+func (c *Checkout) WithStore(storeID string) *Checkout {
+	c.storeID = storeID
+	return c
+}
+
+func (c *Checkout) WithCustomer(customer ShoppingCartCustomerEntity) *Checkout {
+	c.customer = &customer
+	return c
+}
+
+func (c *Checkout) WithBillingAddress(address ShoppingCartCustomerAddressEntity) *Checkout {
+	address.Mode = AddressModeBilling
+	c.billingAddress = &address
+	return c
+}
+
+// WithShippingAddress sets a separate shipping address. Without it the order is
+// shipped to the billing address.
+func (c *Checkout) WithShippingAddress(address ShoppingCartCustomerAddressEntity) *Checkout {
+	address.Mode = AddressModeShipping
+	c.shippingAddress = &address
+	return c
+}
+
+func (c *Checkout) AddProduct(product ShoppingCartProductEntity) *Checkout {
+	c.products = append(c.products, product)
+	return c
+}
+
+func (c *Checkout) WithCoupon(couponCode string) *Checkout {
+	c.couponCode = couponCode
+	return c
+}
+
+// WithShippingMethod sets the shipping method code (e.g. "flatrate_flatrate").
+// When it isn't set the only available shipping method is used.
+func (c *Checkout) WithShippingMethod(code string) *Checkout {
+	c.shippingMethod = code
+	return c
+}
+
+// WithPaymentMethod sets the payment method. When it isn't set the only
+// available payment method is used.
+func (c *Checkout) WithPaymentMethod(method ShoppingCartPaymentMethodEntity) *Checkout {
+	c.paymentMethod = &method
+	return c
+}
+
+// WithLicenses accepts the license agreements with the given IDs, see
+// ShoppingCartService.License()
+func (c *Checkout) WithLicenses(agreementIDs ...string) *Checkout {
+	c.licenses = append(c.licenses, agreementIDs...)
+	return c
+}
+
+// Place runs the shopping cart calls in order and returns the increment id of
+// the created order. Any error is returned as a *CheckoutError.
+func (c *Checkout) Place(ctx context.Context) (string, error) {
+	if ctx == nil {
+		return "", &CheckoutError{Step: "validate", Err: fmt.Errorf("No context")}
+	}
+
+	err := c.validate()
+	if err != nil {
+		return "", &CheckoutError{Step: "validate", Err: err}
+	}
+
+	return c.newRun().place(ctx)
+}
+
+// checkoutRun holds the state of a single Place() call, so placing an order
+// doesn't change the Checkout
+type checkoutRun struct {
+	*Checkout
+
+	quoteID   int
+	addresses []*ShoppingCartCustomerAddressEntity
+}
+
+func (c *Checkout) newRun() *checkoutRun {
+	// the billing address is used as shipping address when no separate
+	// shipping address is set
+	billingAddress := *c.billingAddress
+	shippingAddress := billingAddress
+	shippingAddress.Mode = AddressModeShipping
+	if c.shippingAddress != nil {
+		shippingAddress = *c.shippingAddress
+	}
+
+	return &checkoutRun{
+		Checkout:  c,
+		quoteID:   c.quoteID,
+		addresses: []*ShoppingCartCustomerAddressEntity{&billingAddress, &shippingAddress},
+	}
+}
+
+func (c *checkoutRun) place(ctx context.Context) (string, error) {
+	if c.quoteID == 0 {
+		req := NewShoppingCartCreateRequest()
+		req.StoreID = c.storeID
+		resp, err := c.client.ShoppingCart.Create(ctx, req)
+		if err != nil {
+			return "", c.error(shoppingCartCreateAction, err)
+		}
+		c.quoteID = resp.QuoteID
+	}
+
+	steps := []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{shoppingCartCustomerSetAction, c.setCustomer},
+		{directoryRegionListAction, c.resolveAddresses},
+		{shoppingCartCustomerAddressesAction, c.setAddresses},
+		{shoppingCartProductAddAction, c.addProducts},
+		{shoppingCartCouponAddAction, c.addCoupon},
+		{shoppingCartShippingMethodAction, c.setShippingMethod},
+		{shoppingCartPaymentMethodAction, c.setPaymentMethod},
+	}
+
+	for _, step := range steps {
+		if ctx.Err() != nil {
+			return "", c.error(step.name, ctx.Err())
+		}
+
+		err := step.run(ctx)
+		if err != nil {
+			return "", c.error(step.name, err)
+		}
+	}
+
+	if ctx.Err() != nil {
+		return "", c.error(shoppingCartOrderAction, ctx.Err())
+	}
+
+	req := NewShoppingCartOrderRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.Licenses = c.licenses
+	resp, err := c.client.ShoppingCart.Order(ctx, req)
+	if err != nil {
+		return "", c.error(shoppingCartOrderAction, err)
+	}
+
+	return resp.Result, nil
+}
+
+func (c *Checkout) validate() error {
+	if c.customer == nil {
+		return fmt.Errorf("No customer")
+	}
+
+	if c.billingAddress == nil {
+		return fmt.Errorf("No billing address")
+	}
+
+	if c.quoteID == 0 && len(c.products) == 0 {
+		return fmt.Errorf("No products")
+	}
+
+	return nil
+}
+
+func (c *checkoutRun) setCustomer(ctx context.Context) error {
+	req := NewShoppingCartCustomerSetRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.Customer = c.customer
+	resp, err := c.client.ShoppingCart.CustomerSet(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+// resolveAddresses replaces region names by region IDs for new addresses
+func (c *checkoutRun) resolveAddresses(ctx context.Context) error {
+	for _, address := range c.addresses {
+		if address.AddressID != 0 {
+			continue
+		}
+
+		err := address.ResolveRegion(ctx, c.client)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checkoutRun) setAddresses(ctx context.Context) error {
+	req := NewShoppingCartCustomerAddressesRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	for _, address := range c.addresses {
+		req.Customer = append(req.Customer, *address)
+	}
+
+	resp, err := c.client.ShoppingCart.CustomerAddresses(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+// addProducts adds the products unless the quote already contains products
+// from a previous attempt
+func (c *checkoutRun) addProducts(ctx context.Context) error {
+	listReq := NewShoppingCartProductListRequest()
+	listReq.QuoteID = c.quoteID
+	listReq.StoreID = c.storeID
+	listResp, err := c.client.ShoppingCart.ProductList(ctx, listReq)
+	if err != nil {
+		return err
+	}
+
+	if len(listResp.Result.Items) > 0 {
+		return nil
+	}
+
+	if len(c.products) == 0 {
+		return fmt.Errorf("No products")
+	}
+
+	req := NewShoppingCartProductAddRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.Products = c.products
+	resp, err := c.client.ShoppingCart.ProductAdd(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+func (c *checkoutRun) addCoupon(ctx context.Context) error {
+	if c.couponCode == "" {
+		return nil
+	}
+
+	req := NewShoppingCartCouponAddRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.CouponCode = c.couponCode
+	resp, err := c.client.ShoppingCart.CouponAdd(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+func (c *checkoutRun) setShippingMethod(ctx context.Context) error {
+	listReq := NewShoppingCartShippingListRequest()
+	listReq.QuoteID = c.quoteID
+	listReq.StoreID = c.storeID
+	listResp, err := c.client.ShoppingCart.ShippingList(ctx, listReq)
+	if err != nil {
+		return err
+	}
+
+	codes := make([]string, len(listResp.Result))
+	for i, method := range listResp.Result {
+		codes[i] = method.Code
+	}
+
+	code, err := chooseMethod("shipping", c.shippingMethod, codes)
+	if err != nil {
+		return err
+	}
+
+	req := NewShoppingCartShippingMethodRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.Method = code
+	resp, err := c.client.ShoppingCart.ShippingMethod(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+func (c *checkoutRun) setPaymentMethod(ctx context.Context) error {
+	listReq := NewShoppingCartPaymentListRequest()
+	listReq.QuoteID = c.quoteID
+	listReq.StoreID = c.storeID
+	listResp, err := c.client.ShoppingCart.PaymentList(ctx, listReq)
+	if err != nil {
+		return err
+	}
+
+	codes := make([]string, len(listResp.Result))
+	for i, method := range listResp.Result {
+		codes[i] = method.Code
+	}
+
+	method := ShoppingCartPaymentMethodEntity{}
+	if c.paymentMethod != nil {
+		method = *c.paymentMethod
+	}
+
+	method.Method, err = chooseMethod("payment", method.Method, codes)
+	if err != nil {
+		return err
+	}
+
+	req := NewShoppingCartPaymentMethodRequest()
+	req.QuoteID = c.quoteID
+	req.StoreID = c.storeID
+	req.Method = &method
+	resp, err := c.client.ShoppingCart.PaymentMethod(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkResult(resp.Result)
+}
+
+func (c *checkoutRun) error(step string, err error) *CheckoutError {
+	return &CheckoutError{
+		Step:    step,
+		QuoteID: c.quoteID,
+		Err:     err,
+	}
+}
+
+// chooseMethod checks that code is one of the available methods. When code is
+// empty the only available method is chosen.
+func chooseMethod(kind string, code string, available []string) (string, error) {
+	if code == "" {
+		if len(available) == 1 {
+			return available[0], nil
+		}
+		return "", fmt.Errorf("No %s method set, available: %s", kind, strings.Join(available, ", "))
+	}
+
+	for _, a := range available {
+		if a == code {
+			return code, nil
+		}
+	}
+
+	return "", fmt.Errorf("The %s method \"%s\" is not available, available: %s", kind, code, strings.Join(available, ", "))
+}
+
+func checkResult(result bool) error {
+	if result == false {
+		return fmt.Errorf("Magento returned false")
+	}
+	return nil
+}
+
+// CheckoutError reports the step (the name of the failing operation, e.g.
+// "shoppingCartShippingMethod") at which a checkout failed
+type CheckoutError struct {
+	Step string
+
+	// ID of the quote; 0 when the quote wasn't created
+	QuoteID int
+
+	Err error
+}
+
+func (e *CheckoutError) Error() string {
+	return fmt.Sprintf("Checkout failed at %s (quote %d): %v", e.Step, e.QuoteID, e.Err)
+}
+
+func (e *CheckoutError) Unwrap() error {
+	return e.Err
+}
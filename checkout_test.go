@@ -0,0 +1,207 @@
+package magento
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// checkoutResponses answers the shopping cart calls of a successful checkout
+// on quote 7; productList lists products when the quote already has them
+func checkoutResponses(productList string) func(operation string, body []byte) (int, string) {
+	return func(operation string, body []byte) (int, string) {
+		result := "<result>true</result>"
+		switch operation {
+		case shoppingCartCreateAction:
+			result = "<quoteId>7</quoteId>"
+		case shoppingCartProductListAction:
+			result = "<result>" + productList + "</result>"
+		case shoppingCartShippingListAction:
+			result = "<result><item><code>flatrate_flatrate</code></item></result>"
+		case shoppingCartPaymentListAction:
+			result = "<result><item><code>checkmo</code></item></result>"
+		case shoppingCartOrderAction:
+			result = "<result>100000001</result>"
+		}
+		return 0, "<ns1:" + operation + "Response>" + result + "</ns1:" + operation + "Response>"
+	}
+}
+
+func newTestCheckout(c *Client) *Checkout {
+	// the country list is cached so no directory calls are made
+	c.countries = map[string]string{"nl": "NL"}
+
+	return NewCheckout(c).
+		WithCustomer(ShoppingCartCustomerEntity{Mode: CheckoutModeGuest, Email: "john@example.com"}).
+		WithBillingAddress(ShoppingCartCustomerAddressEntity{Firstname: "John", CountryID: "NL"}).
+		AddProduct(ShoppingCartProductEntity{Sku: "abc", Qty: "1"}).
+		WithCoupon("SUMMER")
+}
+
+func TestCheckoutPlace(t *testing.T) {
+	server := newTestServer(t, checkoutResponses(""))
+	checkout := newTestCheckout(server.client())
+
+	orderID, err := checkout.Place(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orderID != "100000001" {
+		t.Errorf("got order %s, want 100000001", orderID)
+	}
+
+	want := []string{
+		shoppingCartCreateAction,
+		shoppingCartCustomerSetAction,
+		shoppingCartCustomerAddressesAction,
+		shoppingCartProductListAction,
+		shoppingCartProductAddAction,
+		shoppingCartCouponAddAction,
+		shoppingCartShippingListAction,
+		shoppingCartShippingMethodAction,
+		shoppingCartPaymentListAction,
+		shoppingCartPaymentMethodAction,
+		shoppingCartOrderAction,
+	}
+	if got := server.calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got calls %v, want %v", got, want)
+	}
+
+	for i, body := range server.requestBodies()[1:] {
+		if !strings.Contains(string(body), "<quoteId>7</quoteId>") {
+			t.Errorf("%s isn't sent for quote 7: %s", want[i+1], body)
+		}
+	}
+
+	// the shipping address is only set for the call
+	if checkout.shippingAddress != nil || checkout.quoteID != 0 {
+		t.Error("placing the order changed the checkout")
+	}
+}
+
+func TestCheckoutError(t *testing.T) {
+	responses := checkoutResponses("")
+	server := newTestServer(t, func(operation string, body []byte) (int, string) {
+		if operation == shoppingCartShippingMethodAction {
+			return http.StatusInternalServerError, soapFault("1062", "Shipping method is not available")
+		}
+		return responses(operation, body)
+	})
+
+	_, err := newTestCheckout(server.client()).Place(context.Background())
+	checkoutErr := &CheckoutError{}
+	if !errors.As(err, &checkoutErr) {
+		t.Fatalf("got %v, want a *CheckoutError", err)
+	}
+	if checkoutErr.Step != shoppingCartShippingMethodAction || checkoutErr.QuoteID != 7 {
+		t.Errorf("got step %s of quote %d, want %s of quote 7", checkoutErr.Step, checkoutErr.QuoteID, shoppingCartShippingMethodAction)
+	}
+
+	errorResponse := &ErrorResponse{}
+	if !errors.As(err, &errorResponse) || errorResponse.Code != "1062" {
+		t.Errorf("got %v, want fault 1062", err)
+	}
+
+	for _, operation := range server.calls() {
+		if operation == shoppingCartPaymentMethodAction || operation == shoppingCartOrderAction {
+			t.Errorf("%s is called after the failing step", operation)
+		}
+	}
+}
+
+func TestCheckoutResume(t *testing.T) {
+	server := newTestServer(t, checkoutResponses("<item><product_id>1</product_id><sku>abc</sku></item>"))
+
+	_, err := newTestCheckout(server.client()).WithQuoteID(7).Place(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := server.calls()
+	if calls[0] != shoppingCartCustomerSetAction {
+		t.Errorf("got first call %s, want %s", calls[0], shoppingCartCustomerSetAction)
+	}
+	for _, operation := range calls {
+		if operation == shoppingCartCreateAction || operation == shoppingCartProductAddAction {
+			t.Errorf("%s is called when resuming a quote with products", operation)
+		}
+	}
+}
+
+func TestCheckoutCancel(t *testing.T) {
+	server := newTestServer(t, checkoutResponses(""))
+	c := server.client()
+
+	// cancel the context once the customer is set
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Use(func(next RoundTrip) RoundTrip {
+		return func(req *http.Request, request *Request, response *Response) (*http.Response, error) {
+			httpResp, err := next(req, request, response)
+			if request != nil && request.Operation() == shoppingCartCustomerSetAction {
+				cancel()
+			}
+			return httpResp, err
+		}
+	})
+
+	_, err := newTestCheckout(c).Place(ctx)
+	checkoutErr := &CheckoutError{}
+	if !errors.As(err, &checkoutErr) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want a canceled *CheckoutError", err)
+	}
+	if checkoutErr.Step != directoryRegionListAction || checkoutErr.QuoteID != 7 {
+		t.Errorf("got step %s of quote %d, want %s of quote 7", checkoutErr.Step, checkoutErr.QuoteID, directoryRegionListAction)
+	}
+
+	want := []string{shoppingCartCreateAction, shoppingCartCustomerSetAction}
+	if got := server.calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got calls %v, want %v", got, want)
+	}
+}
+
+func TestCheckoutNilContext(t *testing.T) {
+	server := newTestServer(t, checkoutResponses(""))
+
+	// a nil context is what is tested
+	_, err := newTestCheckout(server.client()).Place(nil)
+	if err == nil {
+		t.Fatal("expected an error for a nil context")
+	}
+	if calls := server.calls(); len(calls) > 0 {
+		t.Errorf("got calls %v, want none", calls)
+	}
+}
+
+func TestChooseMethod(t *testing.T) {
+	tests := []struct {
+		code      string
+		available []string
+		want      string
+		valid     bool
+	}{
+		{"", nil, "", false},
+		{"checkmo", nil, "", false},
+		{"", []string{"checkmo"}, "checkmo", true},
+		{"checkmo", []string{"checkmo"}, "checkmo", true},
+		{"free", []string{"checkmo"}, "", false},
+		{"", []string{"checkmo", "free"}, "", false},
+		{"free", []string{"checkmo", "free"}, "free", true},
+	}
+
+	for _, test := range tests {
+		got, err := chooseMethod("payment", test.code, test.available)
+		if test.valid && err != nil {
+			t.Errorf("%q of %v: %s", test.code, test.available, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%q of %v: expected an error", test.code, test.available)
+		}
+		if got != test.want {
+			t.Errorf("%q of %v: got %q, want %q", test.code, test.available, got, test.want)
+		}
+	}
+}
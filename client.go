@@ -5,10 +5,13 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
+	"io"
+	"io/ioutil"
+	"log/slog"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
+	"regexp"
+	"sync"
 	"time"
 )
 
@@ -29,51 +32,146 @@ type Client struct {
 	// Url pointing to base Unit4 Multivers API
 	Endpoint *url.URL
 
+	// Endpoint of the sandbox (or staging) shop, used instead of Endpoint
+	// when Sandbox is set
+	SandboxEndpoint *url.URL
+	Sandbox         bool
+
+	// Guards the endpoints and Sandbox; once requests are sent they should
+	// only be changed with SetEndpoint(), SetSandboxEndpoint() and
+	// SetSandbox()
+	endpointMutex sync.RWMutex
+
 	// Credentials
 	apiUser string
 	apiKey  string
 
-	// Debugging flag
+	// Debugging flag: log requests including their (redacted) bodies to
+	// stderr when no logger is set
 	Debug bool
 
+	// Structured logger, see SetLogger()
+	logger        *slog.Logger
+	logOptions    LogOptions
+	redactPattern []*regexp.Regexp
+	logMutex      sync.Mutex
+
+	// SOAP API version: SoapV2 (default) or SoapV1. In SOAP v1 mode the
+	// endpoint should point to api/soap/ and the services that support it
+	// are dispatched through the v1 call operation.
+	SoapVersion int
+
+	// Use the WS-I compliant message format, for shops that have "WS-I
+	// Compliance" enabled in the Magento API configuration
+	WSICompliance bool
+
+	// Store view of requests that don't set one
+	StoreView string
+
+	// Check the store views and store IDs of requests against the store list
+	// before sending them
+	ValidateStoreView bool
+
+	// Default timeout of an operation (including its retries) and timeouts
+	// per operation name (e.g. "catalogProductList", see OperationName());
+	// 0 means no timeout
+	Timeout           time.Duration
+	OperationTimeouts map[string]time.Duration
+
+	// Retry policy for failed requests; nil (the default) disables retries
+	RetryPolicy *RetryPolicy
+
+	// Optional rate limit and maximum number of concurrent requests
+	rateLimiter   *RateLimiter
+	inFlight      chan struct{}
+	throttleStats ThrottleStats
+	throttleMutex sync.Mutex
+
 	// User agent for client
 	UserAgent string
 
 	// Holds current session
-	session *Session
+	session      *Session
+	sessionMutex sync.Mutex
+
+	// Cached customer group codes and IDs
+	customerGroups      map[string]int
+	customerGroupsMutex sync.Mutex
+
+	// Cached countries and regions per country
+	countries      map[string]string
+	regions        map[string]map[string]int
+	directoryMutex sync.Mutex
+
+	// Cached store codes and IDs
+	stores      map[string]int
+	storesMutex sync.Mutex
 
 	// Optional function called after every successful request made to the DO APIs
 	onRequestCompleted RequestCompletionCallback
 
+	// Middleware wrapping every request, see Use()
+	middleware []Middleware
+
 	// Services
-	CatalogProduct *CatalogProductService
-	Session        *SessionService
+	CatalogProduct       *CatalogProductService
+	Customer             *CustomerService
+	CustomerAddress      *CustomerAddressService
+	CustomerGroup        *CustomerGroupService
+	Directory            *DirectoryService
+	GiftcardAccount      *GiftcardAccountService
+	GiftcardCustomer     *GiftcardCustomerService
+	GiftcardShoppingCart *GiftcardShoppingCartService
+	SalesOrder           *SalesOrderService
+	SalesOrderCreditmemo *SalesOrderCreditmemoService
+	SalesOrderInvoice    *SalesOrderInvoiceService
+	SalesOrderShipment   *SalesOrderShipmentService
+	ShoppingCart         *ShoppingCartService
+	Store                *StoreService
+	Session              *SessionService
 }
 
 // RequestCompletionCallback defines the type of the request callback function
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
-// NewClient returns a new Unit4 Multivers API client
-func NewClient(httpClient *http.Client, baseURL *url.URL, apiUser string, apiKey string) *Client {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
+// NewClient returns a new Magento SOAP API client for the endpoint (e.g.
+// https://shop.example.com/index.php/api/v2_soap/index/):
+//
+//	client := magento.NewClient(endpoint,
+//		magento.WithCredentials("user", "key"),
+//		magento.WithTimeout(30*time.Second),
+//	)
+func NewClient(endpoint *url.URL, opts ...Option) *Client {
 	c := &Client{
-		client:    httpClient,
+		client:    http.DefaultClient,
 		Endpoint:  nil,
 		UserAgent: userAgent,
 		Debug:     false,
 	}
 
-	c.SetEndpoint(baseURL)
-	c.SetApiUser(apiUser)
-	c.SetApiKey(apiKey)
+	c.SetEndpoint(endpoint)
 
 	// Services
 	c.CatalogProduct = NewCatalogProductService(c)
+	c.Customer = NewCustomerService(c)
+	c.CustomerAddress = NewCustomerAddressService(c)
+	c.CustomerGroup = NewCustomerGroupService(c)
+	c.Directory = NewDirectoryService(c)
+	c.GiftcardAccount = NewGiftcardAccountService(c)
+	c.GiftcardCustomer = NewGiftcardCustomerService(c)
+	c.GiftcardShoppingCart = NewGiftcardShoppingCartService(c)
+	c.SalesOrder = NewSalesOrderService(c)
+	c.SalesOrderCreditmemo = NewSalesOrderCreditmemoService(c)
+	c.SalesOrderInvoice = NewSalesOrderInvoiceService(c)
+	c.SalesOrderShipment = NewSalesOrderShipmentService(c)
+	c.ShoppingCart = NewShoppingCartService(c)
+	c.Store = NewStoreService(c)
 	c.Session = NewSessionService(c)
 
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c
 }
 
@@ -81,40 +179,122 @@ func (c *Client) SetDebug(debug bool) {
 	c.Debug = debug
 }
 
+func (c *Client) SetValidateStoreView(validate bool) {
+	c.ValidateStoreView = validate
+}
+
+// SetSandbox switches between the production endpoint and the sandbox
+// endpoint. The session and the cached stores, customer groups and directory
+// data are dropped, so the next request logs in on the other shop.
 func (c *Client) SetSandbox(sandbox bool) {
-	if sandbox == true {
-		// u, _ := url.ParseRequestURI(companies.SandboxEndpoint)
-		// c.Companies.Endpoint = u
-	} else {
-		// u, _ := url.ParseRequestURI(companies.Endpoint)
-		// c.Companies.Endpoint = u
+	c.sessionMutex.Lock()
+	c.endpointMutex.Lock()
+	c.Sandbox = sandbox
+	c.endpointMutex.Unlock()
+	c.session = nil
+	c.sessionMutex.Unlock()
+
+	// the caches are filled while their mutex is held and a session is
+	// fetched, so they're cleared after releasing the session mutex
+	c.ClearStoreCache()
+	c.ClearCustomerGroupCache()
+	c.ClearDirectoryCache()
+}
+
+func (c *Client) SetSandboxEndpoint(endpoint *url.URL) {
+	c.endpointMutex.Lock()
+	defer c.endpointMutex.Unlock()
+	c.SandboxEndpoint = endpoint
+}
+
+func (c *Client) SetWSICompliance(wsi bool) {
+	c.WSICompliance = wsi
+}
+
+func (c *Client) SetStoreView(storeView string) {
+	c.StoreView = storeView
+}
+
+// defaultStoreView returns storeView or, when it's empty, the StoreView of the
+// client
+func (c *Client) defaultStoreView(storeView string) string {
+	if storeView == "" {
+		return c.StoreView
+	}
+	return storeView
+}
+
+// SetTimeout sets the default timeout of every operation
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.Timeout = timeout
+}
+
+// SetOperationTimeout sets the timeout of an operation (e.g.
+// "catalogProductList" or, for SOAP v1, "catalog_product.list"), overriding
+// the default timeout
+func (c *Client) SetOperationTimeout(operation string, timeout time.Duration) {
+	if c.OperationTimeouts == nil {
+		c.OperationTimeouts = map[string]time.Duration{}
 	}
+	c.OperationTimeouts[operation] = timeout
+}
+
+func (c *Client) operationTimeout(operation string) time.Duration {
+	if timeout, ok := c.OperationTimeouts[operation]; ok {
+		return timeout
+	}
+	return c.Timeout
 }
 
 func (c *Client) SetEndpoint(baseURL *url.URL) {
 	// set base url for use in http client
+	c.endpointMutex.Lock()
+	defer c.endpointMutex.Unlock()
 	c.Endpoint = baseURL
 }
 
 func (c *Client) NewRequest(ctx context.Context, body *Request) (*http.Request, error) {
-	u := c.GetEndpoint()
+	u, sandbox := c.endpoint()
+	if u == nil {
+		if sandbox {
+			return nil, fmt.Errorf("No sandbox endpoint set")
+		}
+		return nil, fmt.Errorf("No endpoint set")
+	}
 
 	buf := new(bytes.Buffer)
 	if body != nil {
-		err := xml.NewEncoder(buf).Encode(body.Envelope)
+		body = body.withStoreView(c.StoreView)
+
+		if c.ValidateStoreView {
+			for _, storeView := range body.storeViews() {
+				err := c.CheckStoreView(ctx, storeView)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		err := xml.NewEncoder(buf).Encode(c.envelope(body))
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	req, err := http.NewRequest("POST", u.String(), buf)
+	// NewRequestWithContext rejects a nil ctx
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
 
-	// optionally pass along context
-	if ctx != nil {
-		req = req.WithContext(ctx)
+	// remember the SOAP request for retries and the middleware
+	if body != nil {
+		info := requestInfo{
+			request:    body,
+			operation:  body.Operation(),
+			idempotent: body.Idempotent(),
+		}
+		req = req.WithContext(context.WithValue(req.Context(), requestInfoKey, info))
 	}
 
 	req.Header.Add("Content-Type", fmt.Sprintf("%s; charset=%s", mediaType, charset))
@@ -125,26 +305,109 @@ func (c *Client) NewRequest(ctx context.Context, body *Request) (*http.Request,
 	return req, nil
 }
 
+// GetEndpoint returns the endpoint requests are sent to: the sandbox
+// endpoint when Sandbox is set
 func (c *Client) GetEndpoint() *url.URL {
-	return c.Endpoint
+	u, _ := c.endpoint()
+	return u
+}
+
+// endpoint returns the endpoint requests are sent to and whether it's the
+// sandbox endpoint
+func (c *Client) endpoint() (*url.URL, bool) {
+	c.endpointMutex.RLock()
+	defer c.endpointMutex.RUnlock()
+
+	if c.Sandbox {
+		return c.SandboxEndpoint, true
+	}
+	return c.Endpoint, false
 }
 
 // Do sends an API request and returns the API response. The API response is XML decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+//
+// The request is limited by the timeout of its operation, see
+// SetOperationTimeout(). Failed requests are retried according to the
+// RetryPolicy. Every attempt waits for the rate limit and the maximum number
+// of requests in flight; the middleware runs for every attempt, see
+// Attempt().
 func (c *Client) Do(req *http.Request, responseBody *Response) (*http.Response, error) {
-	if c.Debug == true {
-		dump, _ := httputil.DumpRequestOut(req, true)
-		log.Println(string(dump))
+	if timeout := c.operationTimeout(OperationName(req)); timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
 	}
 
-	httpResp, err := c.client.Do(req)
+	policy := c.RetryPolicy
+	maxAttempts := 1
+	if policy != nil && (policy.RetryNonIdempotent || requestIdempotent(req)) {
+		maxAttempts = policy.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		req = req.WithContext(context.WithValue(req.Context(), requestAttemptKey, attempt))
+		httpResp, err := c.do(req, responseBody)
+		if err == nil || attempt >= maxAttempts || !policy.retryable(httpResp, err) {
+			return httpResp, err
+		}
+
+		// the items that have been streamed can't be taken back
+		if responseBody != nil && responseBody.streamed > 0 {
+			return httpResp, err
+		}
+
+		timer := time.NewTimer(policy.retryDelay(attempt+1, httpResp))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return httpResp, err
+		case <-timer.C:
+		}
+
+		// the body of the previous attempt has been read
+		req, err = retryRequest(req)
+		if err != nil {
+			return httpResp, err
+		}
+	}
+}
+
+func (c *Client) do(req *http.Request, responseBody *Response) (*http.Response, error) {
+	release, err := c.acquire(req.Context())
 	if err != nil {
 		return nil, err
 	}
+	defer release()
+
+	return c.roundTrip()(req, soapRequest(req), responseBody)
+}
+
+// send is the innermost RoundTrip: it encodes the SOAP request (when it
+// isn't nil), sends it and decodes the response
+func (c *Client) send(req *http.Request, request *Request, responseBody *Response) (*http.Response, error) {
+	var body []byte
+	if request != nil {
+		buf := new(bytes.Buffer)
+		err := xml.NewEncoder(buf).Encode(c.envelope(request))
+		if err != nil {
+			return nil, err
+		}
+
+		body = buf.Bytes()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
 
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, httpResp)
+	start := time.Now()
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		c.logRequest(requestLog{req: req, requestBody: body, err: err, duration: time.Since(start)})
+		return nil, err
 	}
 
 	// close body io.Reader
@@ -154,20 +417,44 @@ func (c *Client) Do(req *http.Request, responseBody *Response) (*http.Response,
 		}
 	}()
 
-	if c.Debug == true {
-		dump, _ := httputil.DumpResponse(httpResp, true)
-		log.Println(string(dump))
+	// decode the items while reading the body, see streamItems()
+	if responseBody != nil && responseBody.stream != nil {
+		data, err := c.decodeStream(httpResp, responseBody)
+		c.logRequest(requestLog{
+			req:          req,
+			resp:         httpResp,
+			requestBody:  body,
+			responseBody: data,
+			err:          err,
+			duration:     time.Since(start),
+		})
+		return httpResp, err
 	}
 
-	// check if the response isn't an error
-	err = CheckResponse(httpResp)
-	if err != nil {
-		return httpResp, err
+	// read data and copy it back so it can be logged
+	data, err := ioutil.ReadAll(httpResp.Body)
+	httpResp.Body = nopCloser{bytes.NewReader(data)}
+	httpResp.ContentLength = int64(len(data))
+	if err == nil {
+		err = c.checkAndDecode(httpResp, responseBody)
 	}
 
-	// check the provided interface parameter
-	if httpResp == nil {
-		return httpResp, err
+	c.logRequest(requestLog{
+		req:          req,
+		resp:         httpResp,
+		requestBody:  body,
+		responseBody: data,
+		err:          err,
+		duration:     time.Since(start),
+	})
+	return httpResp, err
+}
+
+func (c *Client) checkAndDecode(httpResp *http.Response, responseBody *Response) error {
+	// check if the response isn't an error
+	err := CheckResponse(httpResp)
+	if err != nil {
+		return err
 	}
 
 	// interface implements io.Writer: write Body to it
@@ -177,14 +464,55 @@ func (c *Client) Do(req *http.Request, responseBody *Response) (*http.Response,
 	// }
 
 	// try to decode body into interface parameter
-	err = xml.NewDecoder(httpResp.Body).Decode(responseBody.Envelope)
+	err = xml.NewDecoder(httpResp.Body).Decode(c.responseEnvelope(responseBody))
 	if err != nil {
 		errorResponse := &ErrorResponse{Response: httpResp}
 		errorResponse.Message = err.Error()
-		return httpResp, errorResponse
+		return errorResponse
 	}
 
-	return httpResp, nil
+	return nil
+}
+
+type contextKey string
+
+const (
+	requestInfoKey    contextKey = "requestInfo"
+	requestAttemptKey contextKey = "requestAttempt"
+)
+
+type requestInfo struct {
+	request    *Request
+	operation  string
+	idempotent bool
+}
+
+// OperationName returns the SOAP operation of a request created with
+// NewRequest(), see Request.Operation()
+func OperationName(req *http.Request) string {
+	info, _ := req.Context().Value(requestInfoKey).(requestInfo)
+	return info.operation
+}
+
+// Attempt returns the attempt of a request sent by Do(): 1 for the first
+// one, 2 for the first retry and so on
+func Attempt(req *http.Request) int {
+	attempt, ok := req.Context().Value(requestAttemptKey).(int)
+	if !ok {
+		return 1
+	}
+	return attempt
+}
+
+// soapRequest returns the SOAP request of a request created with NewRequest()
+func soapRequest(req *http.Request) *Request {
+	info, _ := req.Context().Value(requestInfoKey).(requestInfo)
+	return info.request
+}
+
+func requestIdempotent(req *http.Request) bool {
+	info, ok := req.Context().Value(requestInfoKey).(requestInfo)
+	return ok && info.idempotent
 }
 
 func (c *Client) ApiUser() string {
@@ -203,31 +531,38 @@ func (c *Client) SetApiKey(apiKey string) {
 	c.apiKey = apiKey
 }
 
-func (c *Client) GetSession() *Session {
-	if c.session == nil {
-		c.session = c.Login()
+// GetSession returns the current session and logs in when there is none or
+// it has expired
+func (c *Client) GetSession(ctx context.Context) (*Session, error) {
+	c.sessionMutex.Lock()
+	defer c.sessionMutex.Unlock()
+
+	if c.session != nil && !c.session.IsExpired() {
+		return c.session, nil
 	}
 
-	if c.session.IsExpired() {
-		c.session = c.Login()
+	session, err := c.Login(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return c.session
+	c.session = session
+	return session, nil
 }
 
-func (c *Client) Login() *Session {
+func (c *Client) Login(ctx context.Context) (*Session, error) {
 	now := time.Now()
 	request := NewLoginRequest().
 		WithApiUser(c.ApiUser()).
 		WithApiKey(c.ApiKey())
 
-	resp, err := c.Session.Login(request, nil)
+	resp, err := c.Session.Login(ctx, request)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	return &Session{
 		token:  resp.LoginReturn,
 		expiry: now.Add(sessionTimeout),
-	}
+	}, nil
 }
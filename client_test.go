@@ -0,0 +1,201 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testSessionID = "0123456789abcdef"
+
+// testServer is a SOAP endpoint that answers logins itself and other requests
+// with respond(operation, body), which returns the HTTP status (0 for 200)
+// and the content of the SOAP body (an HTML error page when it's empty)
+type testServer struct {
+	*httptest.Server
+
+	mutex      sync.Mutex
+	operations []string
+	bodies     [][]byte
+}
+
+func newTestServer(t *testing.T, respond func(operation string, body []byte) (int, string)) *testServer {
+	t.Helper()
+
+	s := &testServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		operation := soapOperation(body)
+
+		status, content := http.StatusOK, ""
+		if operation == "login" {
+			content = "<ns1:loginResponse><loginReturn>" + testSessionID + "</loginReturn></ns1:loginResponse>"
+		} else if operation == "loginParam" {
+			content = "<ns1:loginResponseParam><result>" + testSessionID + "</result></ns1:loginResponseParam>"
+		} else {
+			s.mutex.Lock()
+			s.operations = append(s.operations, operation)
+			s.bodies = append(s.bodies, body)
+			s.mutex.Unlock()
+
+			status, content = respond(operation, body)
+			if status == 0 {
+				status = http.StatusOK
+			}
+		}
+
+		// like a proxy in front of Magento
+		if status != http.StatusOK && content == "" {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(status)
+			fmt.Fprintf(w, "<html><body><h1>%s</h1></body></html>", http.StatusText(status))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`, content)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// client returns a client for the server
+func (s *testServer) client(opts ...Option) *Client {
+	u, _ := url.Parse(s.URL)
+	opts = append([]Option{WithCredentials("user", "key")}, opts...)
+	return NewClient(u, opts...)
+}
+
+// calls returns the operations that were requested, except logins
+func (s *testServer) calls() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.operations...)
+}
+
+func (s *testServer) requestBodies() [][]byte {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([][]byte{}, s.bodies...)
+}
+
+// soapOperation returns the name of the first element in the SOAP body
+func soapOperation(body []byte) string {
+	d := xml.NewDecoder(strings.NewReader(string(body)))
+	inBody := false
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if inBody {
+				return start.Name.Local
+			}
+			inBody = start.Name.Local == "Body"
+		}
+	}
+}
+
+func soapFault(code string, message string) string {
+	return "<SOAP-ENV:Fault><faultcode>" + code + "</faultcode><faultstring>" + message + "</faultstring></SOAP-ENV:Fault>"
+}
+
+func TestNewRequestStoreView(t *testing.T) {
+	u, _ := url.Parse("https://shop.example.com/index.php/api/v2_soap/index/")
+	c := NewClient(u, WithStoreView("nl"))
+
+	requestBody := NewCatalogProductListRequest()
+	httpReq, err := c.NewRequest(context.Background(), NewRequest().WithData(requestBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := io.ReadAll(httpReq.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "<storeView>nl</storeView>") {
+		t.Errorf("%s doesn't contain the default store view", data)
+	}
+	if requestBody.StoreView != "" {
+		t.Errorf("the store view of the request is changed to %s", requestBody.StoreView)
+	}
+
+	// the default store view is validated too
+	c.ValidateStoreView = true
+	c.stores = map[string]int{"default": 1, "1": 1}
+	_, err = c.NewRequest(context.Background(), NewRequest().WithData(requestBody))
+	if err == nil {
+		t.Error("expected an error for an unknown default store view")
+	}
+}
+
+func TestSetSandboxClearsCaches(t *testing.T) {
+	c := NewClient(nil)
+	c.stores = map[string]int{"default": 1}
+	c.customerGroups = map[string]int{"General": 1}
+	c.countries = map[string]string{"netherlands": "NL"}
+
+	c.SetSandbox(true)
+	if c.stores != nil || c.customerGroups != nil || c.countries != nil {
+		t.Error("the caches of the production shop are kept")
+	}
+}
+
+func TestSetSandboxConcurrent(t *testing.T) {
+	respond := func(operation string, body []byte) (int, string) {
+		return 0, "<ns1:" + operation + "Response><result></result></ns1:" + operation + "Response>"
+	}
+	server := newTestServer(t, respond)
+	sandbox := newTestServer(t, respond)
+	sandboxURL, _ := url.Parse(sandbox.URL)
+	c := server.client()
+
+	// switching shops while requests are sent (run with -race)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, err := c.CatalogProduct.List(context.Background(), NewCatalogProductListRequest())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+toggle:
+	for i := 0; ; i++ {
+		select {
+		case <-done:
+			break toggle
+		default:
+		}
+
+		c.SetSandboxEndpoint(sandboxURL)
+		c.SetSandbox(i%2 == 0)
+		time.Sleep(100 * time.Microsecond)
+	}
+
+	if len(server.calls())+len(sandbox.calls()) != 40 {
+		t.Errorf("got %d and %d requests, want 40", len(server.calls()), len(sandbox.calls()))
+	}
+}
@@ -0,0 +1,420 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+const (
+	customerCustomerListAction   = "customerCustomerList"
+	customerCustomerInfoAction   = "customerCustomerInfo"
+	customerCustomerCreateAction = "customerCustomerCreate"
+	customerCustomerUpdateAction = "customerCustomerUpdate"
+	customerCustomerDeleteAction = "customerCustomerDelete"
+
+	GenderMale   = 1
+	GenderFemale = 2
+)
+
+// ErrAmbiguousEmail is returned by UpsertByEmail when more than one customer
+// has the email address, e.g. on different websites
+var ErrAmbiguousEmail = errors.New("magento: more than one customer has this email address")
+
+func NewCustomerService(client *Client) *CustomerService {
+	return &CustomerService{Client: client}
+}
+
+type CustomerService struct {
+	Client *Client
+}
+
+func (s *CustomerService) List(ctx context.Context, requestBody *CustomerListRequest) (*CustomerListResponse, error) {
+	responseBody := NewCustomerListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+// ListStream lists the customers like List(), but passes every customer to fn
+// while the response is read instead of keeping the whole list in memory. fn
+// can return ErrStopStream to stop early.
+func (s *CustomerService) ListStream(ctx context.Context, requestBody *CustomerListRequest, fn func(CustomerEntity) error) error {
+	response := NewResponse().WithStream(streamItems(fn))
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return err
+}
+
+// ListAll lists all customers matching the filters in pages of IDs, see
+// WithPageSize() and WithStartAfter():
+//
+//	for customer, err := range client.Customer.ListAll(ctx, filters) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Only one page is kept in memory.
+func (s *CustomerService) ListAll(ctx context.Context, filters *Filters, opts ...PageOption) iter.Seq2[CustomerEntity, error] {
+	config := newPageConfig(customerPageKey, opts)
+	id := func(customer CustomerEntity) int {
+		return customer.CustomerID
+	}
+	list := func(ctx context.Context, filters *Filters, fn func(CustomerEntity) error) error {
+		requestBody := NewCustomerListRequest()
+		requestBody.Filters = filters
+		return s.ListStream(ctx, requestBody, fn)
+	}
+	return listAll(ctx, filters, config, id, list)
+}
+
+func NewCustomerListRequest() *CustomerListRequest {
+	return &CustomerListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerCustomerListAction,
+		},
+	}
+}
+
+type CustomerListRequest struct {
+	XMLName xml.Name `xml:"customerCustomerList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewCustomerListResponse() *CustomerListResponse {
+	return &CustomerListResponse{}
+}
+
+type CustomerListResponse struct {
+	// Magento names the result "storeView", just like catalogProductList
+	StoreView CustomerEntityArray `xml:"storeView"`
+}
+
+type CustomerEntityArray struct {
+	Items []CustomerEntity `xml:"item"`
+}
+
+type CustomerEntity struct {
+	CustomerID  int                 `xml:"customer_id"`
+	CreatedAt   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt   TimeWithoutTimeZone `xml:"updated_at"`
+	IncrementID string              `xml:"increment_id"`
+	StoreID     int                 `xml:"store_id"`
+	WebsiteID   int                 `xml:"website_id"`
+	CreatedIn   string              `xml:"created_in"`
+	Email       string              `xml:"email"`
+	Firstname   string              `xml:"firstname"`
+	Middlename  string              `xml:"middlename"`
+	Lastname    string              `xml:"lastname"`
+	GroupID     int                 `xml:"group_id"`
+	Prefix      string              `xml:"prefix"`
+	Suffix      string              `xml:"suffix"`
+	Dob         TimeWithoutTimeZone `xml:"dob"`
+	Taxvat      string              `xml:"taxvat"`
+	// Confirmation token of accounts that haven't been confirmed yet
+	Confirmation     string              `xml:"confirmation"`
+	PasswordHash     string              `xml:"password_hash"`
+	RpToken          string              `xml:"rp_token"`
+	RpTokenCreatedAt TimeWithoutTimeZone `xml:"rp_token_created_at"`
+	Gender           int                 `xml:"gender"`
+}
+
+func (s *CustomerService) Info(ctx context.Context, requestBody *CustomerInfoRequest) (*CustomerInfoResponse, error) {
+	responseBody := NewCustomerInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerInfoRequest() *CustomerInfoRequest {
+	return &CustomerInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerCustomerInfoAction,
+		},
+	}
+}
+
+type CustomerInfoRequest struct {
+	XMLName xml.Name `xml:"customerCustomerInfo"`
+
+	SessionID  *Session
+	CustomerID int `xml:"customerId"`
+	// Only return these attributes, e.g. "email" or "group_id"
+	Attributes ArrayOfString `xml:"attributes,omitempty"`
+}
+
+func NewCustomerInfoResponse() *CustomerInfoResponse {
+	return &CustomerInfoResponse{}
+}
+
+type CustomerInfoResponse struct {
+	CustomerInfo CustomerEntity `xml:"customerInfo"`
+}
+
+func (s *CustomerService) Create(ctx context.Context, requestBody *CustomerCreateRequest) (*CustomerCreateResponse, error) {
+	customer, err := s.Client.customerWithGroupID(ctx, requestBody.CustomerData)
+	if err != nil {
+		return nil, err
+	}
+	if customer != requestBody.CustomerData {
+		resolved := *requestBody
+		resolved.CustomerData = customer
+		requestBody = &resolved
+	}
+
+	responseBody := NewCustomerCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerCreateRequest() *CustomerCreateRequest {
+	return &CustomerCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerCustomerCreateAction,
+		},
+	}
+}
+
+type CustomerCreateRequest struct {
+	XMLName xml.Name `xml:"customerCustomerCreate"`
+
+	SessionID    *Session
+	CustomerData *CustomerEntityToCreate `xml:"customerData"`
+}
+
+func NewCustomerCreateResponse() *CustomerCreateResponse {
+	return &CustomerCreateResponse{}
+}
+
+type CustomerCreateResponse struct {
+	// ID of the created customer
+	Result int `xml:"result"`
+}
+
+// Empty fields are not sent, so on update only the fields that are set are
+// changed. Leave Password empty to keep the current password.
+type CustomerEntityToCreate struct {
+	Email      string `xml:"email,omitempty"`
+	Firstname  string `xml:"firstname,omitempty"`
+	Middlename string `xml:"middlename,omitempty"`
+	Lastname   string `xml:"lastname,omitempty"`
+	Password   CDATA  `xml:"password,omitempty"`
+	WebsiteID  int    `xml:"website_id,omitempty"`
+	StoreID    int    `xml:"store_id,omitempty"`
+	GroupID    int    `xml:"group_id,omitempty"`
+	// Customer group code like "Wholesale"; Create and Update resolve it to
+	// GroupID when GroupID isn't set
+	GroupCode string              `xml:"-"`
+	Prefix    string              `xml:"prefix,omitempty"`
+	Suffix    string              `xml:"suffix,omitempty"`
+	Dob       TimeWithoutTimeZone `xml:"dob"`
+	Taxvat    string              `xml:"taxvat,omitempty"`
+	Gender    int                 `xml:"gender,omitempty"`
+}
+
+func (s *CustomerService) Update(ctx context.Context, requestBody *CustomerUpdateRequest) (*CustomerUpdateResponse, error) {
+	customer, err := s.Client.customerWithGroupID(ctx, requestBody.CustomerData)
+	if err != nil {
+		return nil, err
+	}
+	if customer != requestBody.CustomerData {
+		resolved := *requestBody
+		resolved.CustomerData = customer
+		requestBody = &resolved
+	}
+
+	responseBody := NewCustomerUpdateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerUpdateRequest() *CustomerUpdateRequest {
+	return &CustomerUpdateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerCustomerUpdateAction,
+		},
+	}
+}
+
+type CustomerUpdateRequest struct {
+	XMLName xml.Name `xml:"customerCustomerUpdate"`
+
+	SessionID    *Session
+	CustomerID   int                     `xml:"customerId"`
+	CustomerData *CustomerEntityToCreate `xml:"customerData"`
+}
+
+func NewCustomerUpdateResponse() *CustomerUpdateResponse {
+	return &CustomerUpdateResponse{}
+}
+
+type CustomerUpdateResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *CustomerService) Delete(ctx context.Context, requestBody *CustomerDeleteRequest) (*CustomerDeleteResponse, error) {
+	responseBody := NewCustomerDeleteResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerDeleteRequest() *CustomerDeleteRequest {
+	return &CustomerDeleteRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerCustomerDeleteAction,
+		},
+	}
+}
+
+type CustomerDeleteRequest struct {
+	XMLName xml.Name `xml:"customerCustomerDelete"`
+
+	SessionID  *Session
+	CustomerID int `xml:"customerId"`
+}
+
+func NewCustomerDeleteResponse() *CustomerDeleteResponse {
+	return &CustomerDeleteResponse{}
+}
+
+type CustomerDeleteResponse struct {
+	Result bool `xml:"result"`
+}
+
+// UpsertByEmail looks up the customer by email (within customer.WebsiteID
+// when it is set) and updates it when it exists or creates it otherwise. It
+// returns the ID of the customer and whether it was created. When more than
+// one customer has the email address nothing is changed and the error wraps
+// ErrAmbiguousEmail; set WebsiteID to pick the account of one website.
+func (s *CustomerService) UpsertByEmail(ctx context.Context, customer *CustomerEntityToCreate) (int, bool, error) {
+	filters := NewFilters().Add("email", customer.Email)
+	if customer.WebsiteID != 0 {
+		filters.Add("website_id", strconv.Itoa(customer.WebsiteID))
+	}
+
+	listRequest := NewCustomerListRequest()
+	listRequest.Filters = filters
+	listResponse, err := s.List(ctx, listRequest)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(listResponse.StoreView.Items) == 0 {
+		createRequest := NewCustomerCreateRequest()
+		createRequest.CustomerData = customer
+		createResponse, err := s.Create(ctx, createRequest)
+		if err != nil {
+			return 0, false, err
+		}
+		return createResponse.Result, true, nil
+	}
+
+	if len(listResponse.StoreView.Items) > 1 {
+		return 0, false, fmt.Errorf("%w: %s (%d customers)", ErrAmbiguousEmail, customer.Email, len(listResponse.StoreView.Items))
+	}
+
+	customerID := listResponse.StoreView.Items[0].CustomerID
+	updateRequest := NewCustomerUpdateRequest()
+	updateRequest.CustomerID = customerID
+	updateRequest.CustomerData = customer
+	_, err = s.Update(ctx, updateRequest)
+	return customerID, false, err
+}
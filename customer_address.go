@@ -0,0 +1,336 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+)
+
+const (
+	customerAddressListAction   = "customerAddressList"
+	customerAddressInfoAction   = "customerAddressInfo"
+	customerAddressCreateAction = "customerAddressCreate"
+	customerAddressUpdateAction = "customerAddressUpdate"
+	customerAddressDeleteAction = "customerAddressDelete"
+)
+
+func NewCustomerAddressService(client *Client) *CustomerAddressService {
+	return &CustomerAddressService{Client: client}
+}
+
+type CustomerAddressService struct {
+	Client *Client
+}
+
+func (s *CustomerAddressService) List(ctx context.Context, requestBody *CustomerAddressListRequest) (*CustomerAddressListResponse, error) {
+	responseBody := NewCustomerAddressListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerAddressListRequest() *CustomerAddressListRequest {
+	return &CustomerAddressListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerAddressListAction,
+		},
+	}
+}
+
+type CustomerAddressListRequest struct {
+	XMLName xml.Name `xml:"customerAddressList"`
+
+	SessionID  *Session
+	CustomerID int `xml:"customerId"`
+}
+
+func NewCustomerAddressListResponse() *CustomerAddressListResponse {
+	return &CustomerAddressListResponse{}
+}
+
+type CustomerAddressListResponse struct {
+	Result CustomerAddressEntityArray `xml:"result"`
+}
+
+type CustomerAddressEntityArray struct {
+	Items []CustomerAddressEntityItem `xml:"item"`
+}
+
+type CustomerAddressEntityItem struct {
+	CustomerAddressID int                 `xml:"customer_address_id"`
+	CreatedAt         TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt         TimeWithoutTimeZone `xml:"updated_at"`
+	IncrementID       string              `xml:"increment_id"`
+	City              string              `xml:"city"`
+	Company           string              `xml:"company"`
+	CountryID         string              `xml:"country_id"`
+	Fax               string              `xml:"fax"`
+	Firstname         string              `xml:"firstname"`
+	Lastname          string              `xml:"lastname"`
+	Middlename        string              `xml:"middlename"`
+	Postcode          string              `xml:"postcode"`
+	Prefix            string              `xml:"prefix"`
+	Region            string              `xml:"region"`
+	RegionID          int                 `xml:"region_id"`
+	// Street lines separated by a newline, see StreetLines()
+	Street            string  `xml:"street"`
+	Suffix            string  `xml:"suffix"`
+	Telephone         string  `xml:"telephone"`
+	IsDefaultBilling  Boolean `xml:"is_default_billing"`
+	IsDefaultShipping Boolean `xml:"is_default_shipping"`
+}
+
+// StreetLines returns the separate lines of the street address
+func (a CustomerAddressEntityItem) StreetLines() []string {
+	if a.Street == "" {
+		return []string{}
+	}
+	return strings.Split(a.Street, "\n")
+}
+
+func (s *CustomerAddressService) Info(ctx context.Context, requestBody *CustomerAddressInfoRequest) (*CustomerAddressInfoResponse, error) {
+	responseBody := NewCustomerAddressInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerAddressInfoRequest() *CustomerAddressInfoRequest {
+	return &CustomerAddressInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerAddressInfoAction,
+		},
+	}
+}
+
+type CustomerAddressInfoRequest struct {
+	XMLName xml.Name `xml:"customerAddressInfo"`
+
+	SessionID *Session
+	AddressID int `xml:"addressId"`
+}
+
+func NewCustomerAddressInfoResponse() *CustomerAddressInfoResponse {
+	return &CustomerAddressInfoResponse{}
+}
+
+type CustomerAddressInfoResponse struct {
+	Info CustomerAddressEntityItem `xml:"info"`
+}
+
+func (s *CustomerAddressService) Create(ctx context.Context, requestBody *CustomerAddressCreateRequest) (*CustomerAddressCreateResponse, error) {
+	responseBody := NewCustomerAddressCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerAddressCreateRequest() *CustomerAddressCreateRequest {
+	return &CustomerAddressCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerAddressCreateAction,
+		},
+	}
+}
+
+type CustomerAddressCreateRequest struct {
+	XMLName xml.Name `xml:"customerAddressCreate"`
+
+	SessionID   *Session
+	CustomerID  int                          `xml:"customerId"`
+	AddressData *CustomerAddressEntityCreate `xml:"addressData"`
+}
+
+func NewCustomerAddressCreateResponse() *CustomerAddressCreateResponse {
+	return &CustomerAddressCreateResponse{}
+}
+
+type CustomerAddressCreateResponse struct {
+	// ID of the created address
+	Result int `xml:"result"`
+}
+
+// Empty fields are not sent, so on update only the fields that are set are
+// changed. Use either Region (free text) or RegionID; RegionID is required for
+// countries where Magento has a list of regions.
+type CustomerAddressEntityCreate struct {
+	City       string `xml:"city,omitempty"`
+	Company    string `xml:"company,omitempty"`
+	CountryID  string `xml:"country_id,omitempty"`
+	Fax        string `xml:"fax,omitempty"`
+	Firstname  string `xml:"firstname,omitempty"`
+	Lastname   string `xml:"lastname,omitempty"`
+	Middlename string `xml:"middlename,omitempty"`
+	Postcode   string `xml:"postcode,omitempty"`
+	Prefix     string `xml:"prefix,omitempty"`
+	RegionID   int    `xml:"region_id,omitempty"`
+	Region     string `xml:"region,omitempty"`
+	// Each line of the street address as a separate item
+	Street    ArrayOfString `xml:"street,omitempty"`
+	Suffix    string        `xml:"suffix,omitempty"`
+	Telephone string        `xml:"telephone,omitempty"`
+	// Default address flags; nil leaves the flag unchanged, see NewBoolean()
+	IsDefaultBilling  *Boolean `xml:"is_default_billing,omitempty"`
+	IsDefaultShipping *Boolean `xml:"is_default_shipping,omitempty"`
+}
+
+// ResolveRegion sets CountryID to the Magento country ID and, for countries
+// with a list of regions, replaces Region by the matching RegionID
+func (a *CustomerAddressEntityCreate) ResolveRegion(ctx context.Context, client *Client) error {
+	countryID, err := client.CountryID(ctx, a.CountryID)
+	if err != nil {
+		return err
+	}
+	a.CountryID = countryID
+
+	if a.Region == "" || a.RegionID != 0 {
+		return nil
+	}
+
+	regionID, err := client.RegionID(ctx, countryID, a.Region)
+	if err != nil {
+		return err
+	}
+
+	if regionID != 0 {
+		a.RegionID = regionID
+		a.Region = ""
+	}
+	return nil
+}
+
+func (s *CustomerAddressService) Update(ctx context.Context, requestBody *CustomerAddressUpdateRequest) (*CustomerAddressUpdateResponse, error) {
+	responseBody := NewCustomerAddressUpdateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerAddressUpdateRequest() *CustomerAddressUpdateRequest {
+	return &CustomerAddressUpdateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerAddressUpdateAction,
+		},
+	}
+}
+
+type CustomerAddressUpdateRequest struct {
+	XMLName xml.Name `xml:"customerAddressUpdate"`
+
+	SessionID   *Session
+	AddressID   int                          `xml:"addressId"`
+	AddressData *CustomerAddressEntityCreate `xml:"addressData"`
+}
+
+func NewCustomerAddressUpdateResponse() *CustomerAddressUpdateResponse {
+	return &CustomerAddressUpdateResponse{}
+}
+
+type CustomerAddressUpdateResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *CustomerAddressService) Delete(ctx context.Context, requestBody *CustomerAddressDeleteRequest) (*CustomerAddressDeleteResponse, error) {
+	responseBody := NewCustomerAddressDeleteResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerAddressDeleteRequest() *CustomerAddressDeleteRequest {
+	return &CustomerAddressDeleteRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerAddressDeleteAction,
+		},
+	}
+}
+
+type CustomerAddressDeleteRequest struct {
+	XMLName xml.Name `xml:"customerAddressDelete"`
+
+	SessionID *Session
+	AddressID int `xml:"addressId"`
+}
+
+func NewCustomerAddressDeleteResponse() *CustomerAddressDeleteResponse {
+	return &CustomerAddressDeleteResponse{}
+}
+
+type CustomerAddressDeleteResponse struct {
+	Result bool `xml:"result"`
+}
@@ -0,0 +1,30 @@
+package magento
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestCustomerAddressDefaultFlags(t *testing.T) {
+	address := &CustomerAddressEntityCreate{City: "Utrecht"}
+	data, err := xml.Marshal(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "is_default") {
+		t.Errorf("unset default flags are sent: %s", data)
+	}
+
+	address.IsDefaultBilling = NewBoolean(true)
+	address.IsDefaultShipping = NewBoolean(false)
+	data, err = xml.Marshal(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<is_default_billing>1</is_default_billing>", "<is_default_shipping>0</is_default_shipping>"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s doesn't contain %s", data, want)
+		}
+	}
+}
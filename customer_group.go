@@ -0,0 +1,174 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	customerGroupListAction = "customerGroupList"
+
+	// tier prices for all customer groups
+	allCustomerGroups = "all"
+)
+
+func NewCustomerGroupService(client *Client) *CustomerGroupService {
+	return &CustomerGroupService{Client: client}
+}
+
+type CustomerGroupService struct {
+	Client *Client
+}
+
+func (s *CustomerGroupService) List(ctx context.Context, requestBody *CustomerGroupListRequest) (*CustomerGroupListResponse, error) {
+	responseBody := NewCustomerGroupListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewCustomerGroupListRequest() *CustomerGroupListRequest {
+	return &CustomerGroupListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: customerGroupListAction,
+		},
+	}
+}
+
+type CustomerGroupListRequest struct {
+	XMLName xml.Name `xml:"customerGroupList"`
+
+	SessionID *Session
+}
+
+func NewCustomerGroupListResponse() *CustomerGroupListResponse {
+	return &CustomerGroupListResponse{}
+}
+
+type CustomerGroupListResponse struct {
+	Result CustomerGroupEntityArray `xml:"result"`
+}
+
+type CustomerGroupEntityArray struct {
+	Items []CustomerGroupEntity `xml:"item"`
+}
+
+type CustomerGroupEntity struct {
+	CustomerGroupID   int    `xml:"customer_group_id"`
+	CustomerGroupCode string `xml:"customer_group_code"`
+}
+
+// CustomerGroupID resolves a customer group code like "Wholesale" to its ID.
+// Numeric values are returned as is. The group list is fetched once and cached
+// on the client; use ClearCustomerGroupCache() to fetch it again.
+func (c *Client) CustomerGroupID(ctx context.Context, code string) (int, error) {
+	if id, err := strconv.Atoi(code); err == nil {
+		return id, nil
+	}
+
+	c.customerGroupsMutex.Lock()
+	defer c.customerGroupsMutex.Unlock()
+
+	if c.customerGroups == nil {
+		resp, err := c.CustomerGroup.List(ctx, NewCustomerGroupListRequest())
+		if err != nil {
+			return 0, err
+		}
+
+		c.customerGroups = make(map[string]int, len(resp.Result.Items))
+		for _, group := range resp.Result.Items {
+			c.customerGroups[group.CustomerGroupCode] = group.CustomerGroupID
+		}
+	}
+
+	if id, ok := c.customerGroups[code]; ok {
+		return id, nil
+	}
+
+	for groupCode, id := range c.customerGroups {
+		if strings.EqualFold(groupCode, code) {
+			return id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Unknown customer group \"%s\"", code)
+}
+
+// customerWithGroupID returns a copy of customer with GroupCode resolved to
+// GroupID, or customer itself when there is nothing to resolve
+func (c *Client) customerWithGroupID(ctx context.Context, customer *CustomerEntityToCreate) (*CustomerEntityToCreate, error) {
+	if customer == nil || customer.GroupCode == "" || customer.GroupID != 0 {
+		return customer, nil
+	}
+
+	id, err := c.CustomerGroupID(ctx, customer.GroupCode)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := *customer
+	resolved.GroupID = id
+	return &resolved, nil
+}
+
+// productWithGroupIDs returns a copy of product with the customer group codes
+// of the tier prices resolved to IDs, or product itself when there is nothing
+// to resolve
+func (c *Client) productWithGroupIDs(ctx context.Context, product *CatalogProductCreateEntity) (*CatalogProductCreateEntity, error) {
+	if product == nil {
+		return product, nil
+	}
+
+	var prices []CatalogProductTierPriceEntity
+	for i, price := range product.TierPrice {
+		group := price.CustomerGroupID
+		if group == "" || group == allCustomerGroups {
+			continue
+		}
+		if _, err := strconv.Atoi(group); err == nil {
+			continue
+		}
+
+		id, err := c.CustomerGroupID(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+
+		if prices == nil {
+			prices = append([]CatalogProductTierPriceEntity{}, product.TierPrice...)
+		}
+		prices[i].CustomerGroupID = strconv.Itoa(id)
+	}
+
+	if prices == nil {
+		return product, nil
+	}
+
+	resolved := *product
+	resolved.TierPrice = prices
+	return &resolved, nil
+}
+
+func (c *Client) ClearCustomerGroupCache() {
+	c.customerGroupsMutex.Lock()
+	defer c.customerGroupsMutex.Unlock()
+	c.customerGroups = nil
+}
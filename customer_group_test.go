@@ -0,0 +1,63 @@
+package magento
+
+import (
+	"context"
+	"testing"
+)
+
+func newGroupClient() *Client {
+	c := NewClient(nil)
+	c.customerGroups = map[string]int{
+		"General":   1,
+		"Wholesale": 2,
+	}
+	return c
+}
+
+func TestCustomerWithGroupID(t *testing.T) {
+	c := newGroupClient()
+	ctx := context.Background()
+
+	customer := &CustomerEntityToCreate{Email: "a@example.com", GroupCode: "wholesale"}
+	resolved, err := c.customerWithGroupID(ctx, customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.GroupID != 2 {
+		t.Errorf("got group %d, want 2", resolved.GroupID)
+	}
+	if customer.GroupID != 0 {
+		t.Errorf("the customer passed in was changed")
+	}
+
+	_, err = c.customerWithGroupID(ctx, &CustomerEntityToCreate{GroupCode: "Retail"})
+	if err == nil {
+		t.Error("expected an error for an unknown group")
+	}
+}
+
+func TestProductWithGroupIDs(t *testing.T) {
+	c := newGroupClient()
+
+	product := &CatalogProductCreateEntity{
+		TierPrice: []CatalogProductTierPriceEntity{
+			{CustomerGroupID: "all", Qty: 10},
+			{CustomerGroupID: "3", Qty: 10},
+			{CustomerGroupID: "Wholesale", Qty: 10},
+		},
+	}
+	resolved, err := c.productWithGroupIDs(context.Background(), product)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"all", "3", "2"}
+	for i, price := range resolved.TierPrice {
+		if price.CustomerGroupID != want[i] {
+			t.Errorf("tier price %d: got group %s, want %s", i, price.CustomerGroupID, want[i])
+		}
+	}
+	if product.TierPrice[2].CustomerGroupID != "Wholesale" {
+		t.Errorf("the product passed in was changed")
+	}
+}
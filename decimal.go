@@ -0,0 +1,244 @@
+package magento
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal holds a decimal value exactly as Magento sends it (e.g. "19.9900")
+// so no precision is lost by converting it to a float. The zero value ("") is
+// treated as 0 in calculations and omitted from requests by fields tagged
+// with omitempty.
+//
+// Arithmetic is done on the unscaled integer value so results are exact:
+// "19.99" + "0.01" = "20.00". The arithmetic and comparison methods return an
+// error when a value isn't a valid decimal (e.g. Decimal("abc")).
+type Decimal string
+
+// ParseDecimal validates s and returns it as a Decimal
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if _, _, err := parseDecimal(s); err != nil {
+		return "", err
+	}
+	return Decimal(s), nil
+}
+
+// MustDecimal is like ParseDecimal but panics when s is not a valid decimal
+func MustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal(strconv.FormatInt(i, 10))
+}
+
+func (d Decimal) String() string {
+	return string(d)
+}
+
+func (d Decimal) Float64() (float64, error) {
+	if d == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(string(d), 64)
+}
+
+func (d Decimal) Add(d2 Decimal) (Decimal, error) {
+	x, y, scale, err := d.aligned(d2)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Add(x, y), scale), nil
+}
+
+func (d Decimal) Sub(d2 Decimal) (Decimal, error) {
+	x, y, scale, err := d.aligned(d2)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Sub(x, y), scale), nil
+}
+
+func (d Decimal) Mul(d2 Decimal) (Decimal, error) {
+	x, xScale, err := parseDecimal(string(d))
+	if err != nil {
+		return "", err
+	}
+	y, yScale, err := parseDecimal(string(d2))
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Mul(x, y), xScale+yScale), nil
+}
+
+func (d Decimal) Neg() (Decimal, error) {
+	x, scale, err := parseDecimal(string(d))
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Neg(x), scale), nil
+}
+
+// Round rounds half away from zero (like PHP's round()) to the given number of
+// decimal places and always returns exactly that many decimals. Negative
+// places round to tens, hundreds, ...: "1250" rounded to -2 places is "1300".
+func (d Decimal) Round(places int) (Decimal, error) {
+	x, scale, err := parseDecimal(string(d))
+	if err != nil {
+		return "", err
+	}
+
+	if places >= scale {
+		return formatDecimal(x.Mul(x, pow10(places-scale)), places), nil
+	}
+
+	divisor := pow10(scale - places)
+	q, r := new(big.Int).QuoRem(x, divisor, new(big.Int))
+	// compare 2*|r| with the divisor to decide whether to round up
+	r.Abs(r).Lsh(r, 1)
+	if r.Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	}
+
+	if places < 0 {
+		// q counts units of 10^-places
+		return formatDecimal(q.Mul(q, pow10(-places)), 0), nil
+	}
+	return formatDecimal(q, places), nil
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than d2
+func (d Decimal) Cmp(d2 Decimal) (int, error) {
+	x, y, _, err := d.aligned(d2)
+	if err != nil {
+		return 0, err
+	}
+	return x.Cmp(y), nil
+}
+
+func (d Decimal) Equal(d2 Decimal) (bool, error) {
+	c, err := d.Cmp(d2)
+	return c == 0, err
+}
+
+func (d Decimal) LessThan(d2 Decimal) (bool, error) {
+	c, err := d.Cmp(d2)
+	return c < 0, err
+}
+
+func (d Decimal) GreaterThan(d2 Decimal) (bool, error) {
+	c, err := d.Cmp(d2)
+	return c > 0, err
+}
+
+func (d Decimal) Sign() (int, error) {
+	x, _, err := parseDecimal(string(d))
+	if err != nil {
+		return 0, err
+	}
+	return x.Sign(), nil
+}
+
+func (d Decimal) IsZero() (bool, error) {
+	sign, err := d.Sign()
+	return sign == 0, err
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	if _, _, err := parseDecimal(string(d)); err != nil {
+		return nil, err
+	}
+	return []byte(d), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	value, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = value
+	return nil
+}
+
+// aligned returns both values as unscaled integers with the same scale
+func (d Decimal) aligned(d2 Decimal) (*big.Int, *big.Int, int, error) {
+	x, xScale, err := parseDecimal(string(d))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	y, yScale, err := parseDecimal(string(d2))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if xScale < yScale {
+		x.Mul(x, pow10(yScale-xScale))
+		return x, y, yScale, nil
+	}
+	y.Mul(y, pow10(xScale-yScale))
+	return x, y, xScale, nil
+}
+
+// parseDecimal parses "-123.4500" into the unscaled value -1234500 and scale 4
+func parseDecimal(s string) (*big.Int, int, error) {
+	if s == "" {
+		return new(big.Int), 0, nil
+	}
+
+	digits := s
+	if digits[0] == '-' || digits[0] == '+' {
+		digits = digits[1:]
+	}
+
+	intPart, fracPart := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		intPart, fracPart = digits[:i], digits[i+1:]
+	}
+
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, 0, fmt.Errorf("Invalid decimal \"%s\"", s)
+	}
+
+	x, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	if s[0] == '-' {
+		x.Neg(x)
+	}
+	return x, len(fracPart), nil
+}
+
+func formatDecimal(x *big.Int, scale int) Decimal {
+	s := new(big.Int).Abs(x).String()
+	if scale > 0 {
+		if len(s) <= scale {
+			s = strings.Repeat("0", scale-len(s)+1) + s
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	}
+
+	if x.Sign() < 0 {
+		s = "-" + s
+	}
+	return Decimal(s)
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
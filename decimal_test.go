@@ -0,0 +1,143 @@
+package magento
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestDecimalArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(Decimal, Decimal) (Decimal, error)
+		x, y Decimal
+		want Decimal
+	}{
+		{"add", Decimal.Add, "19.99", "0.01", "20.00"},
+		{"add scale", Decimal.Add, "19.9900", "0.1", "20.0900"},
+		{"add empty", Decimal.Add, "", "1.5", "1.5"},
+		{"add negative", Decimal.Add, "-1.25", "0.5", "-0.75"},
+		{"sub", Decimal.Sub, "10", "0.01", "9.99"},
+		{"sub to negative", Decimal.Sub, "0.10", "0.25", "-0.15"},
+		{"mul", Decimal.Mul, "19.99", "3", "59.97"},
+		{"mul scale", Decimal.Mul, "1.50", "0.20", "0.3000"},
+		{"mul negative", Decimal.Mul, "-2.5", "-2", "5.0"},
+	}
+
+	for _, test := range tests {
+		got, err := test.op(test.x, test.y)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: %s, %s = %s, want %s", test.name, test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		d      Decimal
+		places int
+		want   Decimal
+	}{
+		{"19.9950", 2, "20.00"},
+		{"19.9949", 2, "19.99"},
+		{"1.005", 2, "1.01"},
+		{"-1.005", 2, "-1.01"},
+		{"-1.004", 2, "-1.00"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"1.5", 3, "1.500"},
+		{"", 2, "0.00"},
+		{"1234", -2, "1200"},
+		{"1250", -2, "1300"},
+		{"-1250", -2, "-1300"},
+		{"1249.99", -2, "1200"},
+		{"49", -2, "0"},
+	}
+
+	for _, test := range tests {
+		got, err := test.d.Round(test.places)
+		if err != nil {
+			t.Errorf("%s rounded to %d: %s", test.d, test.places, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s rounded to %d = %s, want %s", test.d, test.places, got, test.want)
+		}
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	tests := []struct {
+		x, y Decimal
+		want int
+	}{
+		{"1.0", "1", 0},
+		{"1.01", "1.1", -1},
+		{"-0.5", "-0.50", 0},
+		{"-1", "0", -1},
+		{"", "0.00", 0},
+		{"100", "99.999", 1},
+	}
+
+	for _, test := range tests {
+		got, err := test.x.Cmp(test.y)
+		if err != nil {
+			t.Errorf("%s cmp %s: %s", test.x, test.y, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s cmp %s = %d, want %d", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestDecimalInvalid(t *testing.T) {
+	invalid := Decimal("abc")
+
+	if _, err := invalid.Add("1"); err == nil {
+		t.Error("Add: expected an error")
+	}
+	if _, err := Decimal("1").Sub(invalid); err == nil {
+		t.Error("Sub: expected an error")
+	}
+	if _, err := invalid.Mul("1"); err == nil {
+		t.Error("Mul: expected an error")
+	}
+	if _, err := invalid.Neg(); err == nil {
+		t.Error("Neg: expected an error")
+	}
+	if _, err := invalid.Round(2); err == nil {
+		t.Error("Round: expected an error")
+	}
+	if _, err := invalid.Cmp("1"); err == nil {
+		t.Error("Cmp: expected an error")
+	}
+	if _, err := invalid.IsZero(); err == nil {
+		t.Error("IsZero: expected an error")
+	}
+	if _, err := ParseDecimal("1.2.3"); err == nil {
+		t.Error("ParseDecimal: expected an error")
+	}
+}
+
+func TestDecimalXML(t *testing.T) {
+	v := struct {
+		Price Decimal `xml:"price"`
+	}{}
+
+	err := xml.Unmarshal([]byte(`<v><price>19.9900</price></v>`), &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Price != "19.9900" {
+		t.Errorf("got %s, want 19.9900", v.Price)
+	}
+
+	err = xml.Unmarshal([]byte(`<v><price>abc</price></v>`), &v)
+	if err == nil {
+		t.Error("expected an error for an invalid decimal")
+	}
+}
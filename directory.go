@@ -0,0 +1,218 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+const (
+	directoryCountryListAction = "directoryCountryList"
+	directoryRegionListAction  = "directoryRegionList"
+)
+
+func NewDirectoryService(client *Client) *DirectoryService {
+	return &DirectoryService{Client: client}
+}
+
+type DirectoryService struct {
+	Client *Client
+}
+
+func (s *DirectoryService) CountryList(ctx context.Context, requestBody *DirectoryCountryListRequest) (*DirectoryCountryListResponse, error) {
+	responseBody := NewDirectoryCountryListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewDirectoryCountryListRequest() *DirectoryCountryListRequest {
+	return &DirectoryCountryListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: directoryCountryListAction,
+		},
+	}
+}
+
+type DirectoryCountryListRequest struct {
+	XMLName xml.Name `xml:"directoryCountryList"`
+
+	SessionID *Session
+}
+
+func NewDirectoryCountryListResponse() *DirectoryCountryListResponse {
+	return &DirectoryCountryListResponse{}
+}
+
+type DirectoryCountryListResponse struct {
+	Countries DirectoryCountryEntityArray `xml:"countries"`
+}
+
+type DirectoryCountryEntityArray struct {
+	Items []DirectoryCountryEntity `xml:"item"`
+}
+
+type DirectoryCountryEntity struct {
+	CountryID string `xml:"country_id"`
+	Iso2Code  string `xml:"iso2_code"`
+	Iso3Code  string `xml:"iso3_code"`
+	Name      string `xml:"name"`
+}
+
+func (s *DirectoryService) RegionList(ctx context.Context, requestBody *DirectoryRegionListRequest) (*DirectoryRegionListResponse, error) {
+	responseBody := NewDirectoryRegionListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewDirectoryRegionListRequest() *DirectoryRegionListRequest {
+	return &DirectoryRegionListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: directoryRegionListAction,
+		},
+	}
+}
+
+type DirectoryRegionListRequest struct {
+	XMLName xml.Name `xml:"directoryRegionList"`
+
+	SessionID *Session
+	// Country ID (ISO 3166-1 alpha-2 code)
+	Country string `xml:"country"`
+}
+
+func NewDirectoryRegionListResponse() *DirectoryRegionListResponse {
+	return &DirectoryRegionListResponse{}
+}
+
+type DirectoryRegionListResponse struct {
+	// Magento names the result "countries"
+	Countries DirectoryRegionEntityArray `xml:"countries"`
+}
+
+type DirectoryRegionEntityArray struct {
+	Items []DirectoryRegionEntity `xml:"item"`
+}
+
+type DirectoryRegionEntity struct {
+	RegionID int    `xml:"region_id"`
+	Code     string `xml:"code"`
+	Name     string `xml:"name"`
+}
+
+// CountryID resolves an ISO 3166-1 alpha-2 or alpha-3 code or a country name
+// to the Magento country ID. The country list is fetched once and cached on
+// the client.
+func (c *Client) CountryID(ctx context.Context, country string) (string, error) {
+	c.directoryMutex.Lock()
+	defer c.directoryMutex.Unlock()
+
+	return c.countryID(ctx, country)
+}
+
+// RegionID resolves a region name or code (e.g. "California" or "CA") within
+// a country to the Magento region ID. It returns 0 without an error when
+// Magento has no regions for the country; the region name can then be sent as
+// free text. Regions are fetched once per country and cached on the client.
+func (c *Client) RegionID(ctx context.Context, country string, region string) (int, error) {
+	c.directoryMutex.Lock()
+	defer c.directoryMutex.Unlock()
+
+	countryID, err := c.countryID(ctx, country)
+	if err != nil {
+		return 0, err
+	}
+
+	regions, ok := c.regions[countryID]
+	if !ok {
+		request := NewDirectoryRegionListRequest()
+		request.Country = countryID
+		resp, err := c.Directory.RegionList(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+
+		regions = make(map[string]int, len(resp.Countries.Items)*2)
+		for _, r := range resp.Countries.Items {
+			regions[strings.ToLower(r.Code)] = r.RegionID
+			regions[strings.ToLower(r.Name)] = r.RegionID
+		}
+
+		if c.regions == nil {
+			c.regions = make(map[string]map[string]int)
+		}
+		c.regions[countryID] = regions
+	}
+
+	if len(regions) == 0 {
+		return 0, nil
+	}
+
+	if id, ok := regions[strings.ToLower(strings.TrimSpace(region))]; ok {
+		return id, nil
+	}
+
+	return 0, fmt.Errorf("Unknown region \"%s\" for country \"%s\"", region, countryID)
+}
+
+func (c *Client) ClearDirectoryCache() {
+	c.directoryMutex.Lock()
+	defer c.directoryMutex.Unlock()
+	c.countries = nil
+	c.regions = nil
+}
+
+// countryID expects directoryMutex to be locked
+func (c *Client) countryID(ctx context.Context, country string) (string, error) {
+	if c.countries == nil {
+		resp, err := c.Directory.CountryList(ctx, NewDirectoryCountryListRequest())
+		if err != nil {
+			return "", err
+		}
+
+		c.countries = make(map[string]string, len(resp.Countries.Items)*3)
+		for _, country := range resp.Countries.Items {
+			c.countries[strings.ToLower(country.Iso2Code)] = country.CountryID
+			c.countries[strings.ToLower(country.Iso3Code)] = country.CountryID
+			c.countries[strings.ToLower(country.Name)] = country.CountryID
+		}
+	}
+
+	if id, ok := c.countries[strings.ToLower(strings.TrimSpace(country))]; ok {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("Unknown country \"%s\"", country)
+}
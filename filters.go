@@ -1,4 +1,51 @@
 package magento
 
+// <filters xsi:type="urn:filters">
+//    <filter xsi:type="urn:associativeArray" soapenc:arrayType="urn:associativeEntity[]">
+//       <item><key>status</key><value>pending</value></item>
+//    </filter>
+//    <complex_filter xsi:type="urn:complexFilterArray" soapenc:arrayType="urn:complexFilter[]">
+//       <item>
+//          <key>created_at</key>
+//          <value><key>from</key><value>2017-01-01 00:00:00</value></value>
+//       </item>
+//    </complex_filter>
+// </filters>
+
+func NewFilters() *Filters {
+	return &Filters{}
+}
+
 type Filters struct {
+	Filter        AssociativeArray   `xml:"filter,omitempty"`
+	ComplexFilter ComplexFilterArray `xml:"complex_filter,omitempty"`
+}
+
+// Add adds a simple equality filter: key = value
+func (f *Filters) Add(key string, value string) *Filters {
+	f.Filter = append(f.Filter, AssociativeEntity{Key: key, Value: value})
+	return f
+}
+
+// AddComplex adds a filter with a condition operator like "eq", "neq",
+// "like", "in", "gt", "lt", "from" or "to"
+func (f *Filters) AddComplex(key string, operator string, value string) *Filters {
+	f.ComplexFilter = append(f.ComplexFilter, ComplexFilter{
+		Key: key,
+		Value: AssociativeEntity{
+			Key:   operator,
+			Value: value,
+		},
+	})
+	return f
+}
+
+type AssociativeEntity struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type ComplexFilter struct {
+	Key   string            `xml:"key"`
+	Value AssociativeEntity `xml:"value"`
 }
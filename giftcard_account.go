@@ -0,0 +1,303 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	giftcardAccountCreateAction = "giftcardAccountCreate"
+	giftcardAccountListAction   = "giftcardAccountList"
+	giftcardAccountUpdateAction = "giftcardAccountUpdate"
+	giftcardAccountInfoAction   = "giftcardAccountInfo"
+	giftcardAccountRemoveAction = "giftcardAccountRemove"
+)
+
+// Gift card accounts are only available in Magento Enterprise Edition
+func NewGiftcardAccountService(client *Client) *GiftcardAccountService {
+	return &GiftcardAccountService{Client: client}
+}
+
+type GiftcardAccountService struct {
+	Client *Client
+}
+
+func (s *GiftcardAccountService) List(ctx context.Context, requestBody *GiftcardAccountListRequest) (*GiftcardAccountListResponse, error) {
+	responseBody := NewGiftcardAccountListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardAccountListRequest() *GiftcardAccountListRequest {
+	return &GiftcardAccountListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardAccountListAction,
+		},
+	}
+}
+
+type GiftcardAccountListRequest struct {
+	XMLName xml.Name `xml:"giftcardAccountList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewGiftcardAccountListResponse() *GiftcardAccountListResponse {
+	return &GiftcardAccountListResponse{}
+}
+
+type GiftcardAccountListResponse struct {
+	Result []GiftcardAccountListEntity `xml:"result>item"`
+}
+
+type GiftcardAccountListEntity struct {
+	GiftcardID   int                 `xml:"giftcard_id"`
+	Code         string              `xml:"code"`
+	Status       Boolean             `xml:"status"`
+	DateCreated  TimeWithoutTimeZone `xml:"date_created"`
+	DateExpires  string              `xml:"date_expires"`
+	WebsiteID    int                 `xml:"website_id"`
+	Balance      Decimal             `xml:"balance"`
+	State        int                 `xml:"state"`
+	IsRedeemable Boolean             `xml:"is_redeemable"`
+}
+
+func (s *GiftcardAccountService) Info(ctx context.Context, requestBody *GiftcardAccountInfoRequest) (*GiftcardAccountInfoResponse, error) {
+	responseBody := NewGiftcardAccountInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardAccountInfoRequest() *GiftcardAccountInfoRequest {
+	return &GiftcardAccountInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardAccountInfoAction,
+		},
+	}
+}
+
+type GiftcardAccountInfoRequest struct {
+	XMLName xml.Name `xml:"giftcardAccountInfo"`
+
+	SessionID         *Session
+	GiftcardAccountID int `xml:"giftcardAccountId"`
+}
+
+func NewGiftcardAccountInfoResponse() *GiftcardAccountInfoResponse {
+	return &GiftcardAccountInfoResponse{}
+}
+
+type GiftcardAccountInfoResponse struct {
+	Result GiftcardAccountEntity `xml:"result"`
+}
+
+type GiftcardAccountEntity struct {
+	GiftcardID   int                            `xml:"giftcard_id"`
+	Code         string                         `xml:"code"`
+	StoreID      int                            `xml:"store_id"`
+	DateCreated  TimeWithoutTimeZone            `xml:"date_created"`
+	ExpireDate   string                         `xml:"expire_date"`
+	IsActive     Boolean                        `xml:"is_active"`
+	IsRedeemable Boolean                        `xml:"is_redeemable"`
+	History      []GiftcardAccountHistoryEntity `xml:"history>item"`
+	Balance      Decimal                        `xml:"balance"`
+}
+
+type GiftcardAccountHistoryEntity struct {
+	RecordID     int                 `xml:"record_id"`
+	Date         TimeWithoutTimeZone `xml:"date"`
+	Action       string              `xml:"action"`
+	BalanceDelta Decimal             `xml:"balance_delta"`
+	Balance      Decimal             `xml:"balance"`
+	Info         string              `xml:"info"`
+}
+
+func (s *GiftcardAccountService) Create(ctx context.Context, requestBody *GiftcardAccountCreateRequest) (*GiftcardAccountCreateResponse, error) {
+	responseBody := NewGiftcardAccountCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardAccountCreateRequest() *GiftcardAccountCreateRequest {
+	return &GiftcardAccountCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardAccountCreateAction,
+		},
+	}
+}
+
+type GiftcardAccountCreateRequest struct {
+	XMLName xml.Name `xml:"giftcardAccountCreate"`
+
+	SessionID           *Session
+	GiftcardAccountData *GiftcardAccountData             `xml:"giftcardAccountData"`
+	NotificationData    *GiftcardAccountNotificationData `xml:"notificationData,omitempty"`
+}
+
+func NewGiftcardAccountCreateResponse() *GiftcardAccountCreateResponse {
+	return &GiftcardAccountCreateResponse{}
+}
+
+type GiftcardAccountCreateResponse struct {
+	// ID of the created gift card account
+	Result int `xml:"result"`
+}
+
+// Empty fields are not sent, so on update only the fields that are set are
+// changed
+type GiftcardAccountData struct {
+	Status *Boolean `xml:"status,omitempty"`
+	// Date formatted as "2006-01-02"
+	DateExpires  string   `xml:"date_expires,omitempty"`
+	WebsiteID    int      `xml:"website_id,omitempty"`
+	Balance      Decimal  `xml:"balance,omitempty"`
+	State        int      `xml:"state,omitempty"`
+	IsRedeemable *Boolean `xml:"is_redeemable,omitempty"`
+}
+
+// Send the gift card code to a recipient
+type GiftcardAccountNotificationData struct {
+	RecipientName  string `xml:"recipient_name"`
+	RecipientEmail string `xml:"recipient_email"`
+	RecipientStore string `xml:"recipient_store,omitempty"`
+}
+
+func (s *GiftcardAccountService) Update(ctx context.Context, requestBody *GiftcardAccountUpdateRequest) (*GiftcardAccountUpdateResponse, error) {
+	responseBody := NewGiftcardAccountUpdateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardAccountUpdateRequest() *GiftcardAccountUpdateRequest {
+	return &GiftcardAccountUpdateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardAccountUpdateAction,
+		},
+	}
+}
+
+type GiftcardAccountUpdateRequest struct {
+	XMLName xml.Name `xml:"giftcardAccountUpdate"`
+
+	SessionID         *Session
+	GiftcardAccountID int                  `xml:"giftcardAccountId"`
+	GiftcardData      *GiftcardAccountData `xml:"giftcardData"`
+}
+
+func NewGiftcardAccountUpdateResponse() *GiftcardAccountUpdateResponse {
+	return &GiftcardAccountUpdateResponse{}
+}
+
+type GiftcardAccountUpdateResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *GiftcardAccountService) Remove(ctx context.Context, requestBody *GiftcardAccountRemoveRequest) (*GiftcardAccountRemoveResponse, error) {
+	responseBody := NewGiftcardAccountRemoveResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardAccountRemoveRequest() *GiftcardAccountRemoveRequest {
+	return &GiftcardAccountRemoveRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardAccountRemoveAction,
+		},
+	}
+}
+
+type GiftcardAccountRemoveRequest struct {
+	XMLName xml.Name `xml:"giftcardAccountRemove"`
+
+	SessionID         *Session
+	GiftcardAccountID int `xml:"giftcardAccountId"`
+}
+
+func NewGiftcardAccountRemoveResponse() *GiftcardAccountRemoveResponse {
+	return &GiftcardAccountRemoveResponse{}
+}
+
+type GiftcardAccountRemoveResponse struct {
+	Result bool `xml:"result"`
+}
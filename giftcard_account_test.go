@@ -0,0 +1,34 @@
+package magento
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestGiftcardAccountUpdateData(t *testing.T) {
+	req := NewGiftcardAccountUpdateRequest()
+	req.GiftcardAccountID = 1
+	req.GiftcardData = &GiftcardAccountData{Balance: "10.00"}
+	data, err := xml.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{"status", "is_redeemable"} {
+		if strings.Contains(string(data), "<"+field) {
+			t.Errorf("unset %s is sent: %s", field, data)
+		}
+	}
+
+	req.GiftcardData.Status = NewBoolean(false)
+	req.GiftcardData.IsRedeemable = NewBoolean(true)
+	data, err = xml.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<status>0</status>", "<is_redeemable>1</is_redeemable>"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s doesn't contain %s", data, want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	giftcardCustomerInfoAction   = "giftcardCustomerInfo"
+	giftcardCustomerRedeemAction = "giftcardCustomerRedeem"
+)
+
+// Gift cards are only available in Magento Enterprise Edition
+func NewGiftcardCustomerService(client *Client) *GiftcardCustomerService {
+	return &GiftcardCustomerService{Client: client}
+}
+
+type GiftcardCustomerService struct {
+	Client *Client
+}
+
+func (s *GiftcardCustomerService) Info(ctx context.Context, requestBody *GiftcardCustomerInfoRequest) (*GiftcardCustomerInfoResponse, error) {
+	responseBody := NewGiftcardCustomerInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardCustomerInfoRequest() *GiftcardCustomerInfoRequest {
+	return &GiftcardCustomerInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardCustomerInfoAction,
+		},
+	}
+}
+
+type GiftcardCustomerInfoRequest struct {
+	XMLName xml.Name `xml:"giftcardCustomerInfo"`
+
+	SessionID *Session
+	Code      string `xml:"code"`
+}
+
+func NewGiftcardCustomerInfoResponse() *GiftcardCustomerInfoResponse {
+	return &GiftcardCustomerInfoResponse{}
+}
+
+type GiftcardCustomerInfoResponse struct {
+	Result GiftcardCustomerEntity `xml:"result"`
+}
+
+type GiftcardCustomerEntity struct {
+	Balance    Decimal `xml:"balance"`
+	ExpireDate string  `xml:"expire_date"`
+}
+
+func (s *GiftcardCustomerService) Redeem(ctx context.Context, requestBody *GiftcardCustomerRedeemRequest) (*GiftcardCustomerRedeemResponse, error) {
+	responseBody := NewGiftcardCustomerRedeemResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardCustomerRedeemRequest() *GiftcardCustomerRedeemRequest {
+	return &GiftcardCustomerRedeemRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardCustomerRedeemAction,
+		},
+	}
+}
+
+// Redeems the balance of the gift card to the store credit of the customer
+type GiftcardCustomerRedeemRequest struct {
+	XMLName xml.Name `xml:"giftcardCustomerRedeem"`
+
+	SessionID  *Session
+	Code       string `xml:"code"`
+	CustomerID int    `xml:"customerId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewGiftcardCustomerRedeemResponse() *GiftcardCustomerRedeemResponse {
+	return &GiftcardCustomerRedeemResponse{}
+}
+
+type GiftcardCustomerRedeemResponse struct {
+	Result bool `xml:"result"`
+}
@@ -0,0 +1,171 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	giftcardShoppingCartListAction   = "giftcardShoppingCartList"
+	giftcardShoppingCartAddAction    = "giftcardShoppingCartAdd"
+	giftcardShoppingCartRemoveAction = "giftcardShoppingCartRemove"
+)
+
+// Gift cards are only available in Magento Enterprise Edition
+func NewGiftcardShoppingCartService(client *Client) *GiftcardShoppingCartService {
+	return &GiftcardShoppingCartService{Client: client}
+}
+
+type GiftcardShoppingCartService struct {
+	Client *Client
+}
+
+func (s *GiftcardShoppingCartService) List(ctx context.Context, requestBody *GiftcardShoppingCartListRequest) (*GiftcardShoppingCartListResponse, error) {
+	responseBody := NewGiftcardShoppingCartListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardShoppingCartListRequest() *GiftcardShoppingCartListRequest {
+	return &GiftcardShoppingCartListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardShoppingCartListAction,
+		},
+	}
+}
+
+type GiftcardShoppingCartListRequest struct {
+	XMLName xml.Name `xml:"giftcardShoppingCartList"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewGiftcardShoppingCartListResponse() *GiftcardShoppingCartListResponse {
+	return &GiftcardShoppingCartListResponse{}
+}
+
+type GiftcardShoppingCartListResponse struct {
+	Result []GiftcardShoppingCartEntity `xml:"result>item"`
+}
+
+type GiftcardShoppingCartEntity struct {
+	GiftcardID int     `xml:"giftcard_id"`
+	Code       string  `xml:"code"`
+	UsedAmount Decimal `xml:"used_amount"`
+	BaseAmount Decimal `xml:"base_amount"`
+}
+
+func (s *GiftcardShoppingCartService) Add(ctx context.Context, requestBody *GiftcardShoppingCartAddRequest) (*GiftcardShoppingCartAddResponse, error) {
+	responseBody := NewGiftcardShoppingCartAddResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardShoppingCartAddRequest() *GiftcardShoppingCartAddRequest {
+	return &GiftcardShoppingCartAddRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardShoppingCartAddAction,
+		},
+	}
+}
+
+type GiftcardShoppingCartAddRequest struct {
+	XMLName xml.Name `xml:"giftcardShoppingCartAdd"`
+
+	SessionID           *Session
+	GiftcardAccountCode string `xml:"giftcardAccountCode"`
+	QuoteID             int    `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewGiftcardShoppingCartAddResponse() *GiftcardShoppingCartAddResponse {
+	return &GiftcardShoppingCartAddResponse{}
+}
+
+type GiftcardShoppingCartAddResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *GiftcardShoppingCartService) Remove(ctx context.Context, requestBody *GiftcardShoppingCartRemoveRequest) (*GiftcardShoppingCartRemoveResponse, error) {
+	responseBody := NewGiftcardShoppingCartRemoveResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewGiftcardShoppingCartRemoveRequest() *GiftcardShoppingCartRemoveRequest {
+	return &GiftcardShoppingCartRemoveRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: giftcardShoppingCartRemoveAction,
+		},
+	}
+}
+
+type GiftcardShoppingCartRemoveRequest struct {
+	XMLName xml.Name `xml:"giftcardShoppingCartRemove"`
+
+	SessionID           *Session
+	GiftcardAccountCode string `xml:"giftcardAccountCode"`
+	QuoteID             int    `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewGiftcardShoppingCartRemoveResponse() *GiftcardShoppingCartRemoveResponse {
+	return &GiftcardShoppingCartRemoveResponse{}
+}
+
+type GiftcardShoppingCartRemoveResponse struct {
+	Result bool `xml:"result"`
+}
@@ -0,0 +1,266 @@
+package magento
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLogMaxBodySize = 4096
+	logBodySlack          = 1024
+	redacted              = "[REDACTED]"
+)
+
+// Elements that are always redacted: credentials and session IDs
+var credentialFields = []string{
+	"apiKey",
+	"sessionId",
+	"loginReturn",
+	"password",
+	"password_hash",
+}
+
+// DefaultRedactFields are the customer PII elements that are redacted when
+// LogOptions.RedactFields isn't set
+var DefaultRedactFields = []string{
+	"email",
+	"customer_email",
+	"firstname",
+	"lastname",
+	"middlename",
+	"customer_firstname",
+	"customer_lastname",
+	"customer_middlename",
+	"street",
+	"telephone",
+	"fax",
+	"postcode",
+	"dob",
+	"customer_dob",
+	"taxvat",
+	"customer_taxvat",
+	"vat_id",
+	"recipient_name",
+	"recipient_email",
+	"cc_number",
+	"cc_cid",
+	"cc_owner",
+}
+
+// The session ID of a WS-I login response, which isn't in a loginReturn
+// element but in a generic result element
+var loginResultPattern = regexp.MustCompile(`(?s)(<(?:[\w.-]+:)?` + wsiResult + `(?:\s[^>]*)?>)(.*?)(</(?:[\w.-]+:)?` + wsiResult + `>)`)
+
+// used when Debug is set and no logger is
+var debugLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+type LogOptions struct {
+	// Log the request and response bodies (at debug level)
+	LogBodies bool
+
+	// Maximum number of bytes of a body that is logged; 4096 when 0
+	MaxBodySize int
+
+	// Elements with customer data whose content is replaced by [REDACTED];
+	// DefaultRedactFields when nil. Credentials and session IDs are always
+	// redacted.
+	RedactFields []string
+}
+
+// SetLogger sets the logger for requests. Successful requests are logged at
+// debug level, faults at warn level and failed requests at error level with
+// the operation, duration, HTTP status and fault code.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+func (c *Client) SetLogOptions(options LogOptions) {
+	c.logMutex.Lock()
+	defer c.logMutex.Unlock()
+	c.logOptions = options
+	c.redactPattern = nil
+}
+
+// requestLogger returns the logger and options; when Debug is set without a
+// logger the bodies are logged to stderr
+func (c *Client) requestLogger() (*slog.Logger, LogOptions) {
+	if c.logger != nil {
+		return c.logger, c.logOptions
+	}
+
+	if c.Debug == true {
+		options := c.logOptions
+		options.LogBodies = true
+		return debugLogger, options
+	}
+
+	return nil, c.logOptions
+}
+
+type requestLog struct {
+	req          *http.Request
+	resp         *http.Response
+	requestBody  []byte
+	responseBody []byte
+	err          error
+	duration     time.Duration
+}
+
+func (c *Client) logRequest(l requestLog) {
+	logger, options := c.requestLogger()
+	if logger == nil {
+		return
+	}
+
+	ctx := l.req.Context()
+	level := slog.LevelDebug
+	attrs := []slog.Attr{
+		slog.String("operation", OperationName(l.req)),
+		slog.Duration("duration", l.duration),
+		slog.Int("request_size", len(l.requestBody)),
+	}
+
+	if l.resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", l.resp.StatusCode),
+			slog.Int64("response_size", l.resp.ContentLength),
+		)
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(l.err, &errorResponse) {
+		level = slog.LevelWarn
+		attrs = append(attrs,
+			slog.String("fault_code", errorResponse.Code),
+			slog.String("fault", errorResponse.Message),
+		)
+	} else if l.err != nil {
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", l.err.Error()))
+	}
+
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	if options.LogBodies && logger.Enabled(ctx, slog.LevelDebug) {
+		responseBody := l.responseBody
+		if OperationName(l.req) == loginAction {
+			responseBody = loginResultPattern.ReplaceAll(responseBody, []byte("${1}"+redacted+"${3}"))
+		}
+
+		// only the start of streamed responses is captured
+		responseSize := int64(len(responseBody))
+		if l.resp != nil && l.resp.ContentLength > int64(len(l.responseBody)) {
+			responseSize = l.resp.ContentLength
+		}
+
+		attrs = append(attrs,
+			slog.String("request_body", c.logBody(l.requestBody, int64(len(l.requestBody)), options)),
+			slog.String("response_body", c.logBody(responseBody, responseSize, options)),
+		)
+	}
+
+	logger.LogAttrs(ctx, level, "magento soap request", attrs...)
+}
+
+// logBody truncates and redacts a body of size bytes; body can be just the
+// start of it (see decodeStream()). Only a window a little larger than
+// MaxBodySize is redacted, so large bodies aren't scanned completely.
+func (c *Client) logBody(body []byte, size int64, options LogOptions) string {
+	window := logBodyWindow(options)
+	if len(body) > window {
+		body = body[:window]
+	}
+
+	s := c.redact(string(body), options)
+	if int64(len(body)) < size {
+		s = c.dropUnclosed(s, options)
+	}
+
+	max := window - logBodySlack
+	if len(s) > max {
+		s = s[:max]
+	}
+
+	if int64(len(s)) < size {
+		return fmt.Sprintf("%s... (%d bytes)", s, size)
+	}
+	return s
+}
+
+// logBodyWindow returns the number of bytes of a body that is redacted: the
+// MaxBodySize plus some slack for the elements that are cut off by it
+func logBodyWindow(options LogOptions) int {
+	max := options.MaxBodySize
+	if max <= 0 {
+		max = defaultLogMaxBodySize
+	}
+	return max + logBodySlack
+}
+
+// redact replaces the content of the credential and PII elements, both as
+// SOAP v2 elements (<email>...</email>) and as SOAP v1 map entries
+// (<key>email</key><value>...</value>)
+func (c *Client) redact(body string, options LogOptions) string {
+	patterns := c.redactPatterns(options)
+	for _, pattern := range patterns[:2] {
+		body = pattern.ReplaceAllString(body, "${1}"+redacted+"${3}")
+	}
+	return body
+}
+
+// dropUnclosed drops the end of a body that was cut off: a partial tag and
+// any element to redact that isn't closed, as its content wasn't redacted
+func (c *Client) dropUnclosed(body string, options LogOptions) string {
+	body = body[:strings.LastIndex(body, ">")+1]
+
+	opening := c.redactPatterns(options)[2]
+	for _, loc := range opening.FindAllStringIndex(body, -1) {
+		if strings.HasSuffix(body[loc[0]:loc[1]], "/>") {
+			continue
+		}
+		if !strings.HasPrefix(body[loc[1]:], redacted) {
+			return body[:loc[0]]
+		}
+	}
+	return body
+}
+
+// redactPatterns returns the patterns of the SOAP v2 elements and SOAP v1 map
+// entries to redact and a pattern of their opening tags
+func (c *Client) redactPatterns(options LogOptions) []*regexp.Regexp {
+	c.logMutex.Lock()
+	defer c.logMutex.Unlock()
+
+	if c.redactPattern == nil {
+		fields := options.RedactFields
+		if fields == nil {
+			fields = DefaultRedactFields
+		}
+
+		names := []string{}
+		for _, field := range credentialFields {
+			names = append(names, regexp.QuoteMeta(field))
+		}
+		for _, field := range fields {
+			names = append(names, regexp.QuoteMeta(field))
+		}
+		n := strings.Join(names, "|")
+
+		element := `<(?:[\w.-]+:)?(?:` + n + `)(?:\s[^>]*)?>`
+		entry := `<key(?:\s[^>]*)?>\s*(?:` + n + `)\s*</key>\s*<value(?:\s[^>]*)?>`
+		c.redactPattern = []*regexp.Regexp{
+			regexp.MustCompile(`(?s)(` + element + `)(.*?)(</(?:[\w.-]+:)?(?:` + n + `)>)`),
+			regexp.MustCompile(`(?s)(` + entry + `)(.*?)(</value>)`),
+			regexp.MustCompile(element + `|` + entry),
+		}
+	}
+	return c.redactPattern
+}
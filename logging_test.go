@@ -0,0 +1,93 @@
+package magento
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogBodyRedact(t *testing.T) {
+	c := NewClient(nil)
+	options := LogOptions{MaxBodySize: 64}
+
+	body := `<customerCustomerInfoResponse><firstname>John</firstname><key>email</key><value>john@example.com</value></customerCustomerInfoResponse>`
+	got := c.logBody([]byte(body), int64(len(body)), options)
+	for _, secret := range []string{"John", "john@example.com"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("%s contains %s", got, secret)
+		}
+	}
+}
+
+func TestLogBodyCutOff(t *testing.T) {
+	c := NewClient(nil)
+	options := LogOptions{MaxBodySize: 16}
+
+	// larger than the redacted window: the email is cut off before its
+	// closing tag
+	padding := strings.Repeat("<a>x</a>", logBodySlack/8)
+	body := padding + `<email>john@example.com</email>`
+	window := logBodyWindow(options)
+	cut := window - len(padding)
+	if cut <= len("<email>") || cut >= len(body)-len(padding) {
+		t.Fatalf("the window (%d bytes) doesn't cut off the email", window)
+	}
+
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		// buffered responses and requests are passed completely
+		{"buffered", []byte(body + strings.Repeat("<b>y</b>", 1000))},
+		// of streamed responses only the start is captured
+		{"streamed", []byte(body[:window])},
+	}
+
+	for _, test := range tests {
+		got := c.logBody(test.body, int64(len(body))+8000, options)
+		if strings.Contains(got, "john") {
+			t.Errorf("%s: %s contains the email", test.name, got)
+		}
+		if !strings.HasSuffix(got, "bytes)") {
+			t.Errorf("%s: %s isn't marked as truncated", test.name, got)
+		}
+	}
+
+	// the unclosed element is dropped
+	got := c.dropUnclosed(`<a>x</a><email>john@exa`, options)
+	if got != "<a>x</a>" {
+		t.Errorf("got %s, want <a>x</a>", got)
+	}
+	got = c.dropUnclosed(`<key>street</key><value><item>Main st</item><item>1`, options)
+	if got != "" {
+		t.Errorf("got %s, want an empty body", got)
+	}
+}
+
+func TestLogWSILogin(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := server.client(WithWSICompliance(true), WithLogger(logger))
+	c.SetLogOptions(LogOptions{LogBodies: true})
+
+	// the session ID of a WS-I login response is in a result element
+	session, err := c.GetSession(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.token != testSessionID {
+		t.Fatalf("got session %s, want %s", session.token, testSessionID)
+	}
+
+	got := buf.String()
+	if !strings.Contains(got, "loginResponseParam") {
+		t.Fatalf("the response body isn't logged: %s", got)
+	}
+	if strings.Contains(got, testSessionID) {
+		t.Errorf("%s contains the session ID", got)
+	}
+}
@@ -0,0 +1,174 @@
+// Package magentootel adds OpenTelemetry tracing and metrics to a magento
+// client. It's a separate package so the client doesn't depend on
+// OpenTelemetry:
+//
+//	client.Use(magentootel.Middleware())
+//
+// The middleware runs for every attempt of a request, so every attempt gets
+// a client span named after the SOAP operation (e.g. "catalogProductInfo" or,
+// for SOAP v1, "catalog_product.info"); the spans of retries have a
+// magento.attempt attribute above 1. Every attempt is counted in these
+// metrics:
+//
+//	magento.soap.requests  counter of requests
+//	magento.soap.errors    counter of failed requests and faults
+//	magento.soap.logins    counter of login requests
+//	magento.soap.duration  histogram of the request duration in seconds
+package magentootel
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	magento "github.com/tim-online/go-magento-soap"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/tim-online/go-magento-soap/magentootel"
+
+// Attribute keys
+const (
+	OperationKey    = attribute.Key("magento.operation")
+	StoreViewKey    = attribute.Key("magento.store_view")
+	FaultCodeKey    = attribute.Key("magento.fault_code")
+	AttemptKey      = attribute.Key("magento.attempt")
+	RequestSizeKey  = attribute.Key("magento.request.size")
+	ResponseSizeKey = attribute.Key("magento.response.size")
+	StatusCodeKey   = attribute.Key("http.response.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider; the global one is used by
+// default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider; the global one is used by
+// default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+type instruments struct {
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	logins   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newInstruments(meter metric.Meter) instruments {
+	var i instruments
+	var err error
+
+	i.requests, err = meter.Int64Counter("magento.soap.requests",
+		metric.WithDescription("Number of SOAP requests"))
+	handle(err)
+
+	i.errors, err = meter.Int64Counter("magento.soap.errors",
+		metric.WithDescription("Number of failed SOAP requests and faults"))
+	handle(err)
+
+	i.logins, err = meter.Int64Counter("magento.soap.logins",
+		metric.WithDescription("Number of logins"))
+	handle(err)
+
+	i.duration, err = meter.Float64Histogram("magento.soap.duration",
+		metric.WithDescription("Duration of SOAP requests"),
+		metric.WithUnit("s"))
+	handle(err)
+
+	return i
+}
+
+func handle(err error) {
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// Middleware returns the middleware that creates the spans and records the
+// metrics
+func Middleware(options ...Option) magento.Middleware {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, option := range options {
+		option(&c)
+	}
+
+	tracer := c.tracerProvider.Tracer(instrumentationName)
+	i := newInstruments(c.meterProvider.Meter(instrumentationName))
+
+	return func(next magento.RoundTrip) magento.RoundTrip {
+		return func(req *http.Request, request *magento.Request, response *magento.Response) (*http.Response, error) {
+			operation := magento.OperationName(req)
+			if operation == "" {
+				operation = "soap"
+			}
+
+			attrs := []attribute.KeyValue{OperationKey.String(operation)}
+			if request != nil {
+				if storeView := request.StoreView(); storeView != "" {
+					attrs = append(attrs, StoreViewKey.String(storeView))
+				}
+			}
+
+			ctx, span := tracer.Start(req.Context(), operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+				trace.WithAttributes(AttemptKey.Int(magento.Attempt(req))))
+			defer span.End()
+
+			req = req.WithContext(ctx)
+			start := time.Now()
+			httpResp, err := next(req, request, response)
+			elapsed := time.Since(start)
+
+			span.SetAttributes(RequestSizeKey.Int64(req.ContentLength))
+			if httpResp != nil {
+				span.SetAttributes(
+					StatusCodeKey.Int(httpResp.StatusCode),
+					ResponseSizeKey.Int64(httpResp.ContentLength),
+				)
+			}
+
+			i.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+			i.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+			if operation == "login" {
+				i.logins.Add(ctx, 1)
+			}
+
+			if err != nil {
+				errorAttrs := attrs
+				var errorResponse *magento.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Code != "" {
+					errorAttrs = append(errorAttrs, FaultCodeKey.String(errorResponse.Code))
+					span.SetAttributes(FaultCodeKey.String(errorResponse.Code))
+				}
+
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				i.errors.Add(ctx, 1, metric.WithAttributes(errorAttrs...))
+			}
+
+			return httpResp, err
+		}
+	}
+}
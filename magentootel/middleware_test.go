@@ -0,0 +1,118 @@
+package magentootel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	magento "github.com/tim-online/go-magento-soap"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newTestServer returns a SOAP v2 endpoint that logs in and answers every
+// other operation with fault 101
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		status := http.StatusOK
+		content := "<ns1:loginResponse><loginReturn>0123456789abcdef</loginReturn></ns1:loginResponse>"
+		if !strings.Contains(string(body), "login>") {
+			status = http.StatusInternalServerError
+			content = "<SOAP-ENV:Fault><faultcode>101</faultcode><faultstring>Product not exists.</faultstring></SOAP-ENV:Fault>"
+		}
+
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento"><SOAP-ENV:Body>%s</SOAP-ENV:Body></SOAP-ENV:Envelope>`, content)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	// retry the fault once
+	policy := magento.NewRetryPolicy()
+	policy.MaxAttempts = 2
+	policy.InitialBackoff = time.Millisecond
+	policy.FaultCodes = []string{"101"}
+
+	server := newTestServer(t)
+	u, _ := url.Parse(server.URL)
+	c := magento.NewClient(u, magento.WithCredentials("user", "key"), magento.WithRetryPolicy(policy))
+	c.Use(Middleware(WithTracerProvider(tracerProvider), WithMeterProvider(meterProvider)))
+
+	req := magento.NewCatalogProductInfoRequest()
+	req.Product = "abc"
+	req.StoreView = "nl"
+	_, err := c.CatalogProduct.Info(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected fault 101")
+	}
+
+	spans := recorder.Ended()
+	names := []string{}
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	if strings.Join(names, " ") != "login catalogProductInfo catalogProductInfo" {
+		t.Fatalf("got spans %v, want a login span and a span per attempt", names)
+	}
+
+	for i, span := range spans[1:] {
+		attrs := attribute.NewSet(span.Attributes()...)
+		if v, _ := attrs.Value(StoreViewKey); v.AsString() != "nl" {
+			t.Errorf("attempt %d: got store view %q, want nl", i+1, v.AsString())
+		}
+		if v, _ := attrs.Value(FaultCodeKey); v.AsString() != "101" {
+			t.Errorf("attempt %d: got fault code %q, want 101", i+1, v.AsString())
+		}
+		if v, _ := attrs.Value(AttemptKey); v.AsInt64() != int64(i+1) {
+			t.Errorf("attempt %d: got attempt %d", i+1, v.AsInt64())
+		}
+	}
+
+	var metrics metricdata.ResourceMetrics
+	err = reader.Collect(context.Background(), &metrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int64{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					counts[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	want := map[string]int64{
+		"magento.soap.requests": 3,
+		"magento.soap.errors":   2,
+		"magento.soap.logins":   1,
+	}
+	for name, n := range want {
+		if counts[name] != n {
+			t.Errorf("got %s %d, want %d", name, counts[name], n)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package magento
+
+import (
+	"net/http"
+)
+
+// RoundTrip encodes the SOAP request into the body of req, sends it and
+// decodes the HTTP response into response. request is nil for HTTP requests
+// that weren't created with Client.NewRequest(); their body is sent as is.
+type RoundTrip func(req *http.Request, request *Request, response *Response) (*http.Response, error)
+
+// Middleware wraps a RoundTrip, e.g. to add headers, log or measure
+// requests, or to return a response without sending the request:
+//
+//	client.Use(func(next magento.RoundTrip) magento.RoundTrip {
+//		return func(req *http.Request, request *magento.Request, response *magento.Response) (*http.Response, error) {
+//			req.Header.Set("Authorization", "Basic ...")
+//			return next(req, request, response)
+//		}
+//	})
+//
+// The middleware runs for every attempt of a request, after the rate limit
+// and the maximum number of requests in flight.
+type Middleware func(next RoundTrip) RoundTrip
+
+// Use adds middleware; the middleware that is added first is the outermost
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// OnRequestCompleted sets a function that is called after every request that
+// got an HTTP response. The body of the response has been read by then.
+func (c *Client) OnRequestCompleted(callback RequestCompletionCallback) {
+	c.onRequestCompleted = callback
+}
+
+func (c *Client) roundTrip() RoundTrip {
+	roundTrip := c.requestCompleted(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		roundTrip = c.middleware[i](roundTrip)
+	}
+	return roundTrip
+}
+
+// requestCompleted is the middleware that calls the onRequestCompleted
+// callback
+func (c *Client) requestCompleted(next RoundTrip) RoundTrip {
+	return func(req *http.Request, request *Request, response *Response) (*http.Response, error) {
+		httpResp, err := next(req, request, response)
+		if httpResp != nil && c.onRequestCompleted != nil {
+			c.onRequestCompleted(req, httpResp)
+		}
+		return httpResp, err
+	}
+}
@@ -0,0 +1,234 @@
+package magento
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv()
+const (
+	EnvSoapURL        = "MAGENTO_SOAP_URL"
+	EnvSandboxSoapURL = "MAGENTO_SANDBOX_SOAP_URL"
+	EnvSandbox        = "MAGENTO_SANDBOX"
+	EnvApiUser        = "MAGENTO_API_USER"
+	EnvApiKey         = "MAGENTO_API_KEY"
+	EnvStoreView      = "MAGENTO_STORE_VIEW"
+	EnvSoapVersion    = "MAGENTO_SOAP_VERSION"
+	EnvWSICompliance  = "MAGENTO_WSI_COMPLIANCE"
+	EnvTimeout        = "MAGENTO_TIMEOUT"
+	EnvMaxAttempts    = "MAGENTO_RETRY_MAX_ATTEMPTS"
+	EnvUserAgent      = "MAGENTO_USER_AGENT"
+	EnvDebug          = "MAGENTO_DEBUG"
+)
+
+// Option configures a client, see NewClient()
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client; http.DefaultClient is used by default
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+		c.client = httpClient
+	}
+}
+
+// WithCredentials sets the API user and key used to log in
+func WithCredentials(apiUser string, apiKey string) Option {
+	return func(c *Client) {
+		c.SetApiUser(apiUser)
+		c.SetApiKey(apiKey)
+	}
+}
+
+// WithTimeout sets the default timeout of every operation, see SetTimeout()
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.SetTimeout(timeout)
+	}
+}
+
+// WithRetryPolicy sets the retry policy, see SetRetryPolicy()
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.SetRetryPolicy(policy)
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithWSICompliance enables the WS-I compliant message format
+func WithWSICompliance(wsi bool) Option {
+	return func(c *Client) {
+		c.SetWSICompliance(wsi)
+	}
+}
+
+// WithSoapVersion sets the SOAP API version: SoapV2 (default) or SoapV1
+func WithSoapVersion(version int) Option {
+	return func(c *Client) {
+		c.SetSoapVersion(version)
+	}
+}
+
+// WithLogger sets the logger for requests, see SetLogger()
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.SetLogger(logger)
+	}
+}
+
+// WithStoreView sets the store view of requests that don't set one
+func WithStoreView(storeView string) Option {
+	return func(c *Client) {
+		c.SetStoreView(storeView)
+	}
+}
+
+// WithSandbox sets the sandbox (or staging) endpoint and switches to it when
+// enabled is set, see SetSandbox()
+func WithSandbox(endpoint *url.URL, enabled bool) Option {
+	return func(c *Client) {
+		c.SetSandboxEndpoint(endpoint)
+		c.SetSandbox(enabled)
+	}
+}
+
+func WithDebug(debug bool) Option {
+	return func(c *Client) {
+		c.SetDebug(debug)
+	}
+}
+
+// NewClientFromEnv returns a new client configured from the environment:
+//
+//	MAGENTO_SOAP_URL            endpoint (required)
+//	MAGENTO_API_USER            API user
+//	MAGENTO_API_KEY             API key
+//	MAGENTO_SANDBOX_SOAP_URL    sandbox (or staging) endpoint
+//	MAGENTO_SANDBOX             use the sandbox endpoint (true/false)
+//	MAGENTO_STORE_VIEW          store view of requests that don't set one
+//	MAGENTO_SOAP_VERSION        1 or 2 (default)
+//	MAGENTO_WSI_COMPLIANCE      use the WS-I compliant format (true/false)
+//	MAGENTO_TIMEOUT             timeout of an operation, e.g. 30s
+//	MAGENTO_RETRY_MAX_ATTEMPTS  retry failed requests up to this many attempts
+//	MAGENTO_USER_AGENT          user agent
+//	MAGENTO_DEBUG               log requests to stderr (true/false)
+//
+// The options are applied after the environment, so they take precedence.
+func NewClientFromEnv(opts ...Option) (*Client, error) {
+	endpoint, err := envURL(EnvSoapURL)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil {
+		return nil, fmt.Errorf("%s is not set", EnvSoapURL)
+	}
+
+	envOpts := []Option{
+		WithCredentials(os.Getenv(EnvApiUser), os.Getenv(EnvApiKey)),
+	}
+
+	sandboxEndpoint, err := envURL(EnvSandboxSoapURL)
+	if err != nil {
+		return nil, err
+	}
+	sandbox, err := envBool(EnvSandbox)
+	if err != nil {
+		return nil, err
+	}
+	if sandbox && sandboxEndpoint == nil {
+		return nil, fmt.Errorf("%s is set but %s isn't", EnvSandbox, EnvSandboxSoapURL)
+	}
+	if sandboxEndpoint != nil {
+		envOpts = append(envOpts, WithSandbox(sandboxEndpoint, sandbox))
+	}
+
+	if storeView := os.Getenv(EnvStoreView); storeView != "" {
+		envOpts = append(envOpts, WithStoreView(storeView))
+	}
+
+	if s := os.Getenv(EnvSoapVersion); s != "" {
+		version, err := strconv.Atoi(s)
+		if err != nil || (version != SoapV1 && version != SoapV2) {
+			return nil, fmt.Errorf("Invalid %s: %s", EnvSoapVersion, s)
+		}
+		envOpts = append(envOpts, WithSoapVersion(version))
+	}
+
+	wsi, err := envBool(EnvWSICompliance)
+	if err != nil {
+		return nil, err
+	}
+	envOpts = append(envOpts, WithWSICompliance(wsi))
+
+	if s := os.Getenv(EnvTimeout); s != "" {
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s: %s", EnvTimeout, err)
+		}
+		envOpts = append(envOpts, WithTimeout(timeout))
+	}
+
+	if s := os.Getenv(EnvMaxAttempts); s != "" {
+		attempts, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s: %s", EnvMaxAttempts, err)
+		}
+		if attempts > 1 {
+			policy := NewRetryPolicy()
+			policy.MaxAttempts = attempts
+			envOpts = append(envOpts, WithRetryPolicy(policy))
+		}
+	}
+
+	if userAgent := os.Getenv(EnvUserAgent); userAgent != "" {
+		envOpts = append(envOpts, WithUserAgent(userAgent))
+	}
+
+	debug, err := envBool(EnvDebug)
+	if err != nil {
+		return nil, err
+	}
+	envOpts = append(envOpts, WithDebug(debug))
+
+	return NewClient(endpoint, append(envOpts, opts...)...), nil
+}
+
+// envURL parses the URL in an environment variable; it returns nil when the
+// variable isn't set
+func envURL(name string) (*url.URL, error) {
+	s := os.Getenv(name)
+	if s == "" {
+		return nil, nil
+	}
+
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s: %s", name, err)
+	}
+	return u, nil
+}
+
+func envBool(name string) (bool, error) {
+	s := os.Getenv(name)
+	if s == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("Invalid %s: %s", name, s)
+	}
+	return b, nil
+}
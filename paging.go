@@ -0,0 +1,210 @@
+package magento
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 500
+
+	// Upper bound of the ranges that are searched for the next ID; Magento
+	// stores entity IDs as int(10) unsigned, this is the largest one an int
+	// holds on every platform
+	maxEntityID = math.MaxInt32
+)
+
+// Magento 1 keeps one complex filter per key, so the lower and upper bound of
+// a page are set on two keys that map to the entity_id column
+const (
+	catalogProductPageKey = "product_id"
+	customerPageKey       = "customer_id"
+	salesOrderPageKey     = "order_id"
+	entityIDPageKey       = "entity_id"
+)
+
+// PageOption configures the pages of a ListAll() method
+type PageOption func(*pageConfig)
+
+type pageConfig struct {
+	size     int
+	after    int
+	lowerKey string
+	upperKey string
+}
+
+// WithPageSize sets the number of IDs per page (500 by default). A page has
+// fewer items when IDs are missing, e.g. because of the filters.
+func WithPageSize(size int) PageOption {
+	return func(c *pageConfig) {
+		if size > 0 {
+			c.size = size
+		}
+	}
+}
+
+// WithStartAfter resumes listing after the given ID, e.g. the ID of the last
+// item that was processed
+func WithStartAfter(id int) PageOption {
+	return func(c *pageConfig) {
+		c.after = id
+	}
+}
+
+// WithPageKeys sets the filter keys of the lower (gt) and upper (lteq) bound
+// of the ID range of a page. Both have to filter on the entity ID.
+func WithPageKeys(lowerKey string, upperKey string) PageOption {
+	return func(c *pageConfig) {
+		c.lowerKey = lowerKey
+		c.upperKey = upperKey
+	}
+}
+
+func newPageConfig(lowerKey string, opts []PageOption) pageConfig {
+	c := pageConfig{
+		size:     defaultPageSize,
+		lowerKey: lowerKey,
+		upperKey: entityIDPageKey,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// listAll emulates paging by listing the items in ranges of IDs: (after,
+// after+size], (after+size, after+2*size], ... The items of a page are
+// yielded in ID order. When a range is empty, the next ID is searched for in
+// ranges that double in width (see nextID()). The filters can't use the page
+// keys themselves.
+func listAll[T any](ctx context.Context, filters *Filters, config pageConfig, id func(T) int,
+	list func(ctx context.Context, filters *Filters, fn func(T) error) error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		err := checkPageFilters(filters, config)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		after := config.after
+		upper := after + config.size
+
+		for {
+			page := []T{}
+			err := list(ctx, pageFilters(filters, config, after, upper), func(item T) error {
+				page = append(page, item)
+				return nil
+			})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if len(page) == 0 {
+				next, found, err := nextID(ctx, filters, config, upper, id, list)
+				if err != nil {
+					var zero T
+					yield(zero, err)
+					return
+				}
+				if !found {
+					return
+				}
+
+				// continue with the page that starts at the next ID
+				after = next - 1
+				upper = after + config.size
+				continue
+			}
+
+			sort.SliceStable(page, func(i, j int) bool {
+				return id(page[i]) < id(page[j])
+			})
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			after = upper
+			upper = after + config.size
+		}
+	}
+}
+
+// nextID returns the ID of the first item after the given ID. It's searched
+// for in ranges of 2, 4, 8, ... times the page size, up to maxEntityID, so
+// every list request has an upper bound. Magento doesn't sort the items, so
+// the whole range is listed to find the lowest ID.
+func nextID[T any](ctx context.Context, filters *Filters, config pageConfig, after int, id func(T) int,
+	list func(ctx context.Context, filters *Filters, fn func(T) error) error) (int, bool, error) {
+	width := config.size
+	for after < maxEntityID {
+		if width < maxEntityID/2 {
+			width *= 2
+		}
+
+		upper := maxEntityID
+		if width < maxEntityID-after {
+			upper = after + width
+		}
+
+		next := 0
+		found := false
+		err := list(ctx, pageFilters(filters, config, after, upper), func(item T) error {
+			if !found || id(item) < next {
+				next = id(item)
+			}
+			found = true
+			return nil
+		})
+		if err != nil || found {
+			return next, found, err
+		}
+
+		after = upper
+	}
+	return 0, false, nil
+}
+
+// checkPageFilters returns an error when filters use the keys of the ID
+// range, as Magento keeps one condition per key
+func checkPageFilters(filters *Filters, config pageConfig) error {
+	if filters == nil {
+		return nil
+	}
+
+	keys := []string{}
+	for _, filter := range filters.Filter {
+		keys = append(keys, filter.Key)
+	}
+	for _, filter := range filters.ComplexFilter {
+		keys = append(keys, filter.Key)
+	}
+
+	for _, key := range keys {
+		if key == config.lowerKey || key == config.upperKey {
+			return fmt.Errorf("The filters can't use the page key \"%s\", see WithPageKeys()", key)
+		}
+	}
+	return nil
+}
+
+// pageFilters returns a copy of filters with the ID range (after, upper]
+func pageFilters(filters *Filters, config pageConfig, after int, upper int) *Filters {
+	f := NewFilters()
+	if filters != nil {
+		f.Filter = append(AssociativeArray{}, filters.Filter...)
+		f.ComplexFilter = append(ComplexFilterArray{}, filters.ComplexFilter...)
+	}
+
+	f.AddComplex(config.lowerKey, "gt", strconv.Itoa(after))
+	f.AddComplex(config.upperKey, "lteq", strconv.Itoa(upper))
+	return f
+}
@@ -0,0 +1,114 @@
+package magento
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// fakeList lists ids in the given order like a list request with the ID
+// range filters and records the ranges that were requested
+type fakeList struct {
+	ids    []int
+	ranges [][2]int
+}
+
+func (l *fakeList) list(ctx context.Context, filters *Filters, fn func(int) error) error {
+	after, upper := -1, -1
+	for _, filter := range filters.ComplexFilter {
+		value, _ := strconv.Atoi(filter.Value.Value)
+		switch filter.Value.Key {
+		case "gt":
+			after = value
+		case "lteq":
+			upper = value
+		}
+	}
+	l.ranges = append(l.ranges, [2]int{after, upper})
+
+	for _, id := range l.ids {
+		if id <= after || (upper >= 0 && id > upper) {
+			continue
+		}
+		err := fn(id)
+		if err == ErrStopStream {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestListAll(t *testing.T) {
+	l := &fakeList{ids: []int{1, 2, 3, 100000, 100001}}
+	config := newPageConfig(catalogProductPageKey, []PageOption{WithPageSize(2)})
+	id := func(id int) int { return id }
+
+	got := []int{}
+	for item, err := range listAll(context.Background(), nil, config, id, l.list) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	if !reflect.DeepEqual(got, l.ids) {
+		t.Errorf("got %v, want %v", got, l.ids)
+	}
+
+	for _, r := range l.ranges {
+		if r[1] < 0 {
+			t.Errorf("range after %d has no upper bound", r[0])
+		}
+	}
+	if n := len(l.ranges); n > 60 {
+		t.Errorf("%d list requests for 5 items", n)
+	}
+	if last := l.ranges[len(l.ranges)-1]; last[1] != maxEntityID {
+		t.Errorf("the last range ends at %d, want %d", last[1], maxEntityID)
+	}
+}
+
+func TestListAllUnordered(t *testing.T) {
+	// Magento doesn't sort the items of a list request
+	l := &fakeList{ids: []int{100001, 100000, 1}}
+	config := newPageConfig(catalogProductPageKey, []PageOption{WithPageSize(2)})
+	id := func(id int) int { return id }
+
+	got := []int{}
+	for item, err := range listAll(context.Background(), nil, config, id, l.list) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+
+	want := []int{1, 100000, 100001}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListAllPageKeyFilter(t *testing.T) {
+	l := &fakeList{ids: []int{1}}
+	config := newPageConfig(catalogProductPageKey, nil)
+	id := func(id int) int { return id }
+
+	for _, filters := range []*Filters{
+		NewFilters().AddComplex(catalogProductPageKey, "gt", "10"),
+		NewFilters().Add(entityIDPageKey, "1"),
+	} {
+		for _, err := range listAll(context.Background(), filters, config, id, l.list) {
+			if err == nil {
+				t.Error("expected an error for a filter on a page key")
+			}
+			break
+		}
+	}
+	if len(l.ranges) > 0 {
+		t.Errorf("%d list requests were sent", len(l.ranges))
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"strings"
 )
@@ -46,10 +45,6 @@ func CheckResponse(r *http.Response) error {
 	}
 
 	if errorResponse.Message != "" {
-		log.Printf("%+v", errorResponse)
-		log.Println(errorResponse.Message)
-		log.Println(errorResponse.Reason)
-		log.Println(errorResponse.Code)
 		return errorResponse
 	}
 
@@ -0,0 +1,200 @@
+package magento
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+	defaultRetryJitter         = 0.2
+)
+
+// Operations (or SOAP v1 methods) ending in one of these words only read
+// data and are retried by default; all other operations can change something
+var idempotentSuffixes = []string{
+	"list",
+	"info",
+	"items",
+	"tree",
+	"totals",
+	"license",
+	"getcarriers",
+	"login",
+}
+
+// Operations with a read-only suffix that do change something
+var nonIdempotentSuffixes = []string{
+	"sendinfo",
+}
+
+// RetryPolicy decides if and when a failed request is sent again. Requests
+// for operations that aren't idempotent (see IsIdempotent()) are never
+// retried unless RetryNonIdempotent is set.
+//
+//	client.SetRetryPolicy(magento.NewRetryPolicy())
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one
+	MaxAttempts int
+
+	// Delay before the second attempt; it's doubled for every next attempt
+	// up to MaxBackoff (no maximum when 0)
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Random part of the delay as a fraction of it (0-1)
+	Jitter float64
+
+	// Also retry operations that aren't idempotent (create, add, order, ...
+	// and operations sent with Client.Call())
+	RetryNonIdempotent bool
+
+	// SOAP fault codes that are retried
+	FaultCodes []string
+
+	// Retryable reports whether a failed attempt should be retried; when nil
+	// DefaultRetryable is used
+	Retryable func(resp *http.Response, err error) bool
+}
+
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    defaultRetryMaxAttempts,
+		InitialBackoff: defaultRetryInitialBackoff,
+		MaxBackoff:     defaultRetryMaxBackoff,
+		Jitter:         defaultRetryJitter,
+	}
+}
+
+// SetRetryPolicy sets the retry policy used by Do(); nil disables retries
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.RetryPolicy = policy
+}
+
+// Backoff returns the delay before the given attempt (2 is the first retry)
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 2; i < attempt && backoff < math.MaxInt64/2; i++ {
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+		backoff *= 2
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		jitter := (rand.Float64()*2 - 1) * p.Jitter * float64(backoff)
+		backoff += time.Duration(jitter)
+	}
+
+	return backoff
+}
+
+func (p *RetryPolicy) retryable(resp *http.Response, err error) bool {
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		for _, code := range p.FaultCodes {
+			if errorResponse.Code == code {
+				return true
+			}
+		}
+	}
+
+	if p.Retryable != nil {
+		return p.Retryable(resp, err)
+	}
+	return DefaultRetryable(resp, err)
+}
+
+// DefaultRetryable retries network errors, the HTTP statuses 429, 502, 503
+// and 504 and faults caused by a database deadlock or lock wait timeout
+func DefaultRetryable(resp *http.Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		message := errorResponse.Message
+		return strings.Contains(message, "Deadlock found") ||
+			strings.Contains(message, "Lock wait timeout exceeded")
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// IsIdempotent reports whether an operation (e.g. "catalogProductInfo") or
+// SOAP v1 resource path (e.g. "catalog_product.info") can be sent again
+// without side effects. Only operations that read data (list, info, ...)
+// are.
+func IsIdempotent(operation string) bool {
+	operation = strings.ToLower(operation[strings.LastIndex(operation, ".")+1:])
+	for _, suffix := range nonIdempotentSuffixes {
+		if strings.HasSuffix(operation, suffix) {
+			return false
+		}
+	}
+
+	for _, suffix := range idempotentSuffixes {
+		if strings.HasSuffix(operation, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns the backoff of the policy or the Retry-After of the
+// response when that's longer
+func (p *RetryPolicy) retryDelay(attempt int, resp *http.Response) time.Duration {
+	delay := p.Backoff(attempt)
+	if resp == nil {
+		return delay
+	}
+
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err == nil && time.Duration(seconds)*time.Second > delay {
+		delay = time.Duration(seconds) * time.Second
+	}
+	return delay
+}
+
+// retryRequest returns a copy of req with a new body for the next attempt
+func retryRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody == nil {
+		return retry, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retry.Body = body
+	return retry, nil
+}
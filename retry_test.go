@@ -0,0 +1,222 @@
+package magento
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		operation string
+		want      bool
+	}{
+		{"catalogProductList", true},
+		{"catalog_product.info", true},
+		{"salesOrderInfo", true},
+		{"catalogProductCreate", false},
+		{"shoppingCartProductAdd", false},
+		{"salesOrderAddComment", false},
+		{"salesOrderShipmentSendInfo", false},
+		{"sales_order_invoice.capture", false},
+		{"salesOrderCancel", false},
+		{"salesOrderInvoiceVoid", false},
+		{"salesOrderHold", false},
+		{"sales_order.unhold", false},
+		{"shoppingCartProductMoveToCustomerQuote", false},
+		{"customOrderExport", false},
+		{"shoppingCartTotals", true},
+		{"salesOrderShipmentGetCarriers", true},
+		{"login", true},
+	}
+
+	for _, test := range tests {
+		if got := IsIdempotent(test.operation); got != test.want {
+			t.Errorf("IsIdempotent(%s) = %t, want %t", test.operation, got, test.want)
+		}
+	}
+}
+
+// newRetryServer fails the first failures requests of every operation with
+// the given status and fault, and answers the rest with an empty result
+func newRetryServer(t *testing.T, failures int, status int, fault string) *testServer {
+	attempts := map[string]int{}
+	var mutex sync.Mutex
+	return newTestServer(t, func(operation string, body []byte) (int, string) {
+		mutex.Lock()
+		attempts[operation]++
+		n := attempts[operation]
+		mutex.Unlock()
+
+		if n <= failures {
+			return status, fault
+		}
+		return 0, "<ns1:" + operation + "Response><result>1</result></ns1:" + operation + "Response>"
+	})
+}
+
+func newTestRetryPolicy() *RetryPolicy {
+	policy := NewRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+func TestRetryBadGateway(t *testing.T) {
+	server := newRetryServer(t, 1, http.StatusBadGateway, "")
+	c := server.client(WithRetryPolicy(newTestRetryPolicy()))
+
+	// the middleware runs for every attempt
+	attempts := []int{}
+	c.Use(func(next RoundTrip) RoundTrip {
+		return func(req *http.Request, request *Request, response *Response) (*http.Response, error) {
+			if OperationName(req) == catalogProductListAction {
+				attempts = append(attempts, Attempt(req))
+			}
+			return next(req, request, response)
+		}
+	})
+
+	_, err := c.CatalogProduct.List(context.Background(), NewCatalogProductListRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.calls()); n != 2 {
+		t.Errorf("got %d attempts, want 2", n)
+	}
+	if !reflect.DeepEqual(attempts, []int{1, 2}) {
+		t.Errorf("got attempts %v, want [1 2]", attempts)
+	}
+}
+
+func TestRetryFaultCode(t *testing.T) {
+	fault := soapFault("5", "Session expired")
+
+	// faults aren't retried by default
+	server := newRetryServer(t, 1, http.StatusInternalServerError, fault)
+	c := server.client(WithRetryPolicy(newTestRetryPolicy()))
+	_, err := c.CatalogProduct.List(context.Background(), NewCatalogProductListRequest())
+	if err == nil || len(server.calls()) != 1 {
+		t.Errorf("got %v after %d attempts, want the fault after 1 attempt", err, len(server.calls()))
+	}
+
+	server = newRetryServer(t, 1, http.StatusInternalServerError, fault)
+	policy := newTestRetryPolicy()
+	policy.FaultCodes = []string{"5"}
+	c = server.client(WithRetryPolicy(policy))
+	_, err = c.CatalogProduct.List(context.Background(), NewCatalogProductListRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.calls()); n != 2 {
+		t.Errorf("got %d attempts, want 2", n)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	create := func(c *Client) error {
+		requestBody := NewCatalogProductCreateRequest()
+		requestBody.Sku = "abc"
+		_, err := c.CatalogProduct.Create(context.Background(), requestBody)
+		return err
+	}
+
+	server := newRetryServer(t, 1, http.StatusBadGateway, "")
+	err := create(server.client(WithRetryPolicy(newTestRetryPolicy())))
+	if err == nil || len(server.calls()) != 1 {
+		t.Errorf("got %v after %d attempts, want an error after 1 attempt", err, len(server.calls()))
+	}
+
+	// Call() is not retried either
+	server = newRetryServer(t, 1, http.StatusBadGateway, "")
+	err = server.client(WithRetryPolicy(newTestRetryPolicy())).Call(context.Background(), "customOrderExport", nil, nil)
+	if err == nil || len(server.calls()) != 1 {
+		t.Errorf("got %v after %d attempts, want an error after 1 attempt", err, len(server.calls()))
+	}
+
+	server = newRetryServer(t, 1, http.StatusBadGateway, "")
+	err = server.client(WithRetryPolicy(newTestRetryPolicy())).CallIdempotent(context.Background(), "customOrderExport", nil, nil)
+	if err != nil || len(server.calls()) != 2 {
+		t.Errorf("got %v after %d attempts, want no error after 2 attempts", err, len(server.calls()))
+	}
+
+	server = newRetryServer(t, 1, http.StatusBadGateway, "")
+	policy := newTestRetryPolicy()
+	policy.RetryNonIdempotent = true
+	err = create(server.client(WithRetryPolicy(policy)))
+	if err != nil || len(server.calls()) != 2 {
+		t.Errorf("got %v after %d attempts, want no error after 2 attempts", err, len(server.calls()))
+	}
+}
+
+func TestRetryCancelDuringBackoff(t *testing.T) {
+	server := newRetryServer(t, 3, http.StatusBadGateway, "")
+	policy := newTestRetryPolicy()
+	policy.InitialBackoff = time.Minute
+	c := server.client(WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.CatalogProduct.List(ctx, NewCatalogProductListRequest())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the backoff isn't interrupted: the call took %s", elapsed)
+	}
+	if n := len(server.calls()); n != 1 {
+		t.Errorf("got %d attempts, want 1", n)
+	}
+}
+
+func TestRetryRequestBody(t *testing.T) {
+	server := newRetryServer(t, 2, http.StatusServiceUnavailable, "")
+	c := server.client(WithRetryPolicy(newTestRetryPolicy()))
+
+	requestBody := NewCatalogProductListRequest()
+	requestBody.Filters = NewFilters().Add("sku", "abc")
+	_, err := c.CatalogProduct.List(context.Background(), requestBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bodies := server.requestBodies()
+	if len(bodies) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(bodies))
+	}
+	for i, body := range bodies[1:] {
+		if !bytes.Equal(body, bodies[0]) {
+			t.Errorf("attempt %d sent %s, want %s", i+2, body, bodies[0])
+		}
+	}
+	if !bytes.Contains(bodies[0], []byte("<value>abc</value>")) {
+		t.Errorf("the filters aren't sent: %s", bodies[0])
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		policy  RetryPolicy
+		attempt int
+		want    time.Duration
+	}{
+		{RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second}, 2, time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second}, 3, 2 * time.Second},
+		{RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second}, 4, 3 * time.Second},
+		// no maximum
+		{RetryPolicy{InitialBackoff: time.Second}, 4, 4 * time.Second},
+		{RetryPolicy{InitialBackoff: time.Second}, 6, 16 * time.Second},
+	}
+
+	for _, test := range tests {
+		if got := test.policy.Backoff(test.attempt); got != test.want {
+			t.Errorf("Backoff(%d) with maximum %s = %s, want %s", test.attempt, test.policy.MaxBackoff, got, test.want)
+		}
+	}
+}
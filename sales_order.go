@@ -0,0 +1,572 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"iter"
+)
+
+const (
+	salesOrderListAction       = "salesOrderList"
+	salesOrderInfoAction       = "salesOrderInfo"
+	salesOrderAddCommentAction = "salesOrderAddComment"
+	salesOrderHoldAction       = "salesOrderHold"
+	salesOrderUnholdAction     = "salesOrderUnhold"
+	salesOrderCancelAction     = "salesOrderCancel"
+)
+
+func NewSalesOrderService(client *Client) *SalesOrderService {
+	return &SalesOrderService{Client: client}
+}
+
+type SalesOrderService struct {
+	Client *Client
+}
+
+func (s *SalesOrderService) List(ctx context.Context, requestBody *SalesOrderListRequest) (*SalesOrderListResponse, error) {
+	responseBody := NewSalesOrderListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+// ListStream lists the orders like List(), but passes every order to fn
+// while the response is read instead of keeping the whole list in memory. fn
+// can return ErrStopStream to stop early.
+func (s *SalesOrderService) ListStream(ctx context.Context, requestBody *SalesOrderListRequest, fn func(SalesOrderListEntity) error) error {
+	response := NewResponse().WithStream(streamItems(fn))
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return err
+}
+
+// ListAll lists all orders matching the filters in pages of IDs, see
+// WithPageSize() and WithStartAfter():
+//
+//	for order, err := range client.SalesOrder.ListAll(ctx, filters) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Only one page is kept in memory.
+func (s *SalesOrderService) ListAll(ctx context.Context, filters *Filters, opts ...PageOption) iter.Seq2[SalesOrderListEntity, error] {
+	config := newPageConfig(salesOrderPageKey, opts)
+	id := func(order SalesOrderListEntity) int {
+		return order.OrderID
+	}
+	list := func(ctx context.Context, filters *Filters, fn func(SalesOrderListEntity) error) error {
+		requestBody := NewSalesOrderListRequest()
+		requestBody.Filters = filters
+		return s.ListStream(ctx, requestBody, fn)
+	}
+	return listAll(ctx, filters, config, id, list)
+}
+
+func NewSalesOrderListRequest() *SalesOrderListRequest {
+	return &SalesOrderListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderListAction,
+		},
+	}
+}
+
+type SalesOrderListRequest struct {
+	XMLName xml.Name `xml:"salesOrderList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewSalesOrderListResponse() *SalesOrderListResponse {
+	return &SalesOrderListResponse{}
+}
+
+type SalesOrderListResponse struct {
+	Result SalesOrderListEntityArray `xml:"result"`
+}
+
+// ListByCouponCode lists the orders in which the coupon code was used.
+// Magento 1 has no SOAP resource for sales rules or coupons, so this is the
+// only way to check the usage of a coupon through the API.
+func (s *SalesOrderService) ListByCouponCode(ctx context.Context, couponCode string) ([]SalesOrderListEntity, error) {
+	request := NewSalesOrderListRequest()
+	request.Filters = NewFilters().Add("coupon_code", couponCode)
+	response, err := s.List(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Result.Items, nil
+}
+
+type SalesOrderListEntityArray struct {
+	Items []SalesOrderListEntity `xml:"item"`
+}
+
+type SalesOrderListEntity struct {
+	IncrementID              string              `xml:"increment_id"`
+	StoreID                  int                 `xml:"store_id"`
+	CreatedAt                TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt                TimeWithoutTimeZone `xml:"updated_at"`
+	CustomerID               int                 `xml:"customer_id"`
+	TaxAmount                Decimal             `xml:"tax_amount"`
+	ShippingAmount           Decimal             `xml:"shipping_amount"`
+	DiscountAmount           Decimal             `xml:"discount_amount"`
+	Subtotal                 Decimal             `xml:"subtotal"`
+	GrandTotal               Decimal             `xml:"grand_total"`
+	TotalPaid                Decimal             `xml:"total_paid"`
+	TotalRefunded            Decimal             `xml:"total_refunded"`
+	TotalQtyOrdered          Decimal             `xml:"total_qty_ordered"`
+	TotalCanceled            Decimal             `xml:"total_canceled"`
+	TotalInvoiced            Decimal             `xml:"total_invoiced"`
+	TotalOnlineRefunded      Decimal             `xml:"total_online_refunded"`
+	TotalOfflineRefunded     Decimal             `xml:"total_offline_refunded"`
+	BaseTaxAmount            Decimal             `xml:"base_tax_amount"`
+	BaseShippingAmount       Decimal             `xml:"base_shipping_amount"`
+	BaseDiscountAmount       Decimal             `xml:"base_discount_amount"`
+	BaseSubtotal             Decimal             `xml:"base_subtotal"`
+	BaseGrandTotal           Decimal             `xml:"base_grand_total"`
+	BaseTotalPaid            Decimal             `xml:"base_total_paid"`
+	BaseTotalRefunded        Decimal             `xml:"base_total_refunded"`
+	BaseTotalQtyOrdered      Decimal             `xml:"base_total_qty_ordered"`
+	BaseTotalCanceled        Decimal             `xml:"base_total_canceled"`
+	BaseTotalInvoiced        Decimal             `xml:"base_total_invoiced"`
+	BaseTotalOnlineRefunded  Decimal             `xml:"base_total_online_refunded"`
+	BaseTotalOfflineRefunded Decimal             `xml:"base_total_offline_refunded"`
+	BillingAddressID         int                 `xml:"billing_address_id"`
+	BillingFirstname         string              `xml:"billing_firstname"`
+	BillingLastname          string              `xml:"billing_lastname"`
+	ShippingAddressID        int                 `xml:"shipping_address_id"`
+	ShippingFirstname        string              `xml:"shipping_firstname"`
+	ShippingLastname         string              `xml:"shipping_lastname"`
+	BillingName              string              `xml:"billing_name"`
+	ShippingName             string              `xml:"shipping_name"`
+	StoreToBaseRate          Decimal             `xml:"store_to_base_rate"`
+	StoreToOrderRate         Decimal             `xml:"store_to_order_rate"`
+	BaseToGlobalRate         Decimal             `xml:"base_to_global_rate"`
+	BaseToOrderRate          Decimal             `xml:"base_to_order_rate"`
+	Weight                   Decimal             `xml:"weight"`
+	StoreName                string              `xml:"store_name"`
+	RemoteIP                 string              `xml:"remote_ip"`
+	Status                   string              `xml:"status"`
+	State                    string              `xml:"state"`
+	AppliedRuleIDs           string              `xml:"applied_rule_ids"`
+	GlobalCurrencyCode       string              `xml:"global_currency_code"`
+	BaseCurrencyCode         string              `xml:"base_currency_code"`
+	StoreCurrencyCode        string              `xml:"store_currency_code"`
+	OrderCurrencyCode        string              `xml:"order_currency_code"`
+	ShippingMethod           string              `xml:"shipping_method"`
+	ShippingDescription      string              `xml:"shipping_description"`
+	CustomerEmail            string              `xml:"customer_email"`
+	CustomerFirstname        string              `xml:"customer_firstname"`
+	CustomerLastname         string              `xml:"customer_lastname"`
+	QuoteID                  int                 `xml:"quote_id"`
+	IsVirtual                Boolean             `xml:"is_virtual"`
+	CustomerGroupID          int                 `xml:"customer_group_id"`
+	CustomerNoteNotify       Boolean             `xml:"customer_note_notify"`
+	CustomerIsGuest          Boolean             `xml:"customer_is_guest"`
+	EmailSent                Boolean             `xml:"email_sent"`
+	OrderID                  int                 `xml:"order_id"`
+	GiftMessageID            int                 `xml:"gift_message_id"`
+	CouponCode               string              `xml:"coupon_code"`
+	ProtectCode              string              `xml:"protect_code"`
+	DiscountDescription      string              `xml:"discount_description"`
+	CustomerDob              string              `xml:"customer_dob"`
+	CustomerTaxvat           string              `xml:"customer_taxvat"`
+	CustomerGender           string              `xml:"customer_gender"`
+	TotalDue                 Decimal             `xml:"total_due"`
+	BaseTotalDue             Decimal             `xml:"base_total_due"`
+}
+
+func (s *SalesOrderService) Info(ctx context.Context, requestBody *SalesOrderInfoRequest) (*SalesOrderInfoResponse, error) {
+	responseBody := NewSalesOrderInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInfoRequest() *SalesOrderInfoRequest {
+	return &SalesOrderInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInfoAction,
+		},
+	}
+}
+
+type SalesOrderInfoRequest struct {
+	XMLName xml.Name `xml:"salesOrderInfo"`
+
+	SessionID        *Session
+	OrderIncrementID string `xml:"orderIncrementId"`
+}
+
+func NewSalesOrderInfoResponse() *SalesOrderInfoResponse {
+	return &SalesOrderInfoResponse{}
+}
+
+type SalesOrderInfoResponse struct {
+	Result SalesOrderEntity `xml:"result"`
+}
+
+type SalesOrderEntity struct {
+	SalesOrderListEntity
+
+	ParentID        int                             `xml:"parent_id"`
+	IsActive        Boolean                         `xml:"is_active"`
+	GiftMessage     string                          `xml:"gift_message"`
+	ShippingAddress SalesOrderAddressEntity         `xml:"shipping_address"`
+	BillingAddress  SalesOrderAddressEntity         `xml:"billing_address"`
+	Items           []SalesOrderItemEntity          `xml:"items>item"`
+	Payment         SalesOrderPaymentEntity         `xml:"payment"`
+	StatusHistory   []SalesOrderStatusHistoryEntity `xml:"status_history>item"`
+}
+
+type SalesOrderAddressEntity struct {
+	IncrementID string              `xml:"increment_id"`
+	ParentID    int                 `xml:"parent_id"`
+	CreatedAt   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt   TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive    Boolean             `xml:"is_active"`
+	AddressType string              `xml:"address_type"`
+	Firstname   string              `xml:"firstname"`
+	Lastname    string              `xml:"lastname"`
+	Company     string              `xml:"company"`
+	Street      string              `xml:"street"`
+	City        string              `xml:"city"`
+	Region      string              `xml:"region"`
+	Postcode    string              `xml:"postcode"`
+	CountryID   string              `xml:"country_id"`
+	Telephone   string              `xml:"telephone"`
+	Fax         string              `xml:"fax"`
+	RegionID    int                 `xml:"region_id"`
+	AddressID   int                 `xml:"address_id"`
+}
+
+type SalesOrderItemEntity struct {
+	ItemID                      int                 `xml:"item_id"`
+	OrderID                     int                 `xml:"order_id"`
+	QuoteItemID                 int                 `xml:"quote_item_id"`
+	CreatedAt                   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt                   TimeWithoutTimeZone `xml:"updated_at"`
+	ProductID                   int                 `xml:"product_id"`
+	ProductType                 string              `xml:"product_type"`
+	ProductOptions              string              `xml:"product_options"`
+	Weight                      Decimal             `xml:"weight"`
+	IsVirtual                   Boolean             `xml:"is_virtual"`
+	Sku                         string              `xml:"sku"`
+	Name                        string              `xml:"name"`
+	AppliedRuleIDs              string              `xml:"applied_rule_ids"`
+	FreeShipping                Boolean             `xml:"free_shipping"`
+	IsQtyDecimal                Boolean             `xml:"is_qty_decimal"`
+	NoDiscount                  Boolean             `xml:"no_discount"`
+	QtyCanceled                 Decimal             `xml:"qty_canceled"`
+	QtyInvoiced                 Decimal             `xml:"qty_invoiced"`
+	QtyOrdered                  Decimal             `xml:"qty_ordered"`
+	QtyRefunded                 Decimal             `xml:"qty_refunded"`
+	QtyShipped                  Decimal             `xml:"qty_shipped"`
+	Cost                        Decimal             `xml:"cost"`
+	Price                       Decimal             `xml:"price"`
+	BasePrice                   Decimal             `xml:"base_price"`
+	OriginalPrice               Decimal             `xml:"original_price"`
+	BaseOriginalPrice           Decimal             `xml:"base_original_price"`
+	TaxPercent                  Decimal             `xml:"tax_percent"`
+	TaxAmount                   Decimal             `xml:"tax_amount"`
+	BaseTaxAmount               Decimal             `xml:"base_tax_amount"`
+	TaxInvoiced                 Decimal             `xml:"tax_invoiced"`
+	BaseTaxInvoiced             Decimal             `xml:"base_tax_invoiced"`
+	DiscountPercent             Decimal             `xml:"discount_percent"`
+	DiscountAmount              Decimal             `xml:"discount_amount"`
+	BaseDiscountAmount          Decimal             `xml:"base_discount_amount"`
+	DiscountInvoiced            Decimal             `xml:"discount_invoiced"`
+	BaseDiscountInvoiced        Decimal             `xml:"base_discount_invoiced"`
+	AmountRefunded              Decimal             `xml:"amount_refunded"`
+	BaseAmountRefunded          Decimal             `xml:"base_amount_refunded"`
+	RowTotal                    Decimal             `xml:"row_total"`
+	BaseRowTotal                Decimal             `xml:"base_row_total"`
+	RowInvoiced                 Decimal             `xml:"row_invoiced"`
+	BaseRowInvoiced             Decimal             `xml:"base_row_invoiced"`
+	RowWeight                   Decimal             `xml:"row_weight"`
+	GiftMessageID               int                 `xml:"gift_message_id"`
+	GiftMessage                 string              `xml:"gift_message"`
+	GiftMessageAvailable        Boolean             `xml:"gift_message_available"`
+	BaseTaxBeforeDiscount       Decimal             `xml:"base_tax_before_discount"`
+	TaxBeforeDiscount           Decimal             `xml:"tax_before_discount"`
+	WeeeTaxApplied              string              `xml:"weee_tax_applied"`
+	WeeeTaxAppliedAmount        Decimal             `xml:"weee_tax_applied_amount"`
+	WeeeTaxAppliedRowAmount     Decimal             `xml:"weee_tax_applied_row_amount"`
+	BaseWeeeTaxAppliedAmount    Decimal             `xml:"base_weee_tax_applied_amount"`
+	BaseWeeeTaxAppliedRowAmount Decimal             `xml:"base_weee_tax_applied_row_amount"`
+	WeeeTaxDisposition          Decimal             `xml:"weee_tax_disposition"`
+	WeeeTaxRowDisposition       Decimal             `xml:"weee_tax_row_disposition"`
+	BaseWeeeTaxDisposition      Decimal             `xml:"base_weee_tax_disposition"`
+	BaseWeeeTaxRowDisposition   Decimal             `xml:"base_weee_tax_row_disposition"`
+	ParentItemID                int                 `xml:"parent_item_id"`
+}
+
+type SalesOrderPaymentEntity struct {
+	IncrementID        string              `xml:"increment_id"`
+	ParentID           int                 `xml:"parent_id"`
+	CreatedAt          TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt          TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive           Boolean             `xml:"is_active"`
+	AmountOrdered      Decimal             `xml:"amount_ordered"`
+	ShippingAmount     Decimal             `xml:"shipping_amount"`
+	BaseAmountOrdered  Decimal             `xml:"base_amount_ordered"`
+	BaseShippingAmount Decimal             `xml:"base_shipping_amount"`
+	Method             string              `xml:"method"`
+	PoNumber           string              `xml:"po_number"`
+	CcType             string              `xml:"cc_type"`
+	CcNumberEnc        string              `xml:"cc_number_enc"`
+	CcLast4            string              `xml:"cc_last4"`
+	CcOwner            string              `xml:"cc_owner"`
+	CcExpMonth         string              `xml:"cc_exp_month"`
+	CcExpYear          string              `xml:"cc_exp_year"`
+	CcSsStartMonth     string              `xml:"cc_ss_start_month"`
+	CcSsStartYear      string              `xml:"cc_ss_start_year"`
+	PaymentID          int                 `xml:"payment_id"`
+}
+
+type SalesOrderStatusHistoryEntity struct {
+	IncrementID        string              `xml:"increment_id"`
+	ParentID           int                 `xml:"parent_id"`
+	CreatedAt          TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt          TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive           Boolean             `xml:"is_active"`
+	IsCustomerNotified Boolean             `xml:"is_customer_notified"`
+	Status             string              `xml:"status"`
+	Comment            string              `xml:"comment"`
+}
+
+func (s *SalesOrderService) AddComment(ctx context.Context, requestBody *SalesOrderAddCommentRequest) (*SalesOrderAddCommentResponse, error) {
+	responseBody := NewSalesOrderAddCommentResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderAddCommentRequest() *SalesOrderAddCommentRequest {
+	return &SalesOrderAddCommentRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderAddCommentAction,
+		},
+	}
+}
+
+type SalesOrderAddCommentRequest struct {
+	XMLName xml.Name `xml:"salesOrderAddComment"`
+
+	SessionID        *Session
+	OrderIncrementID string  `xml:"orderIncrementId"`
+	Status           string  `xml:"status"`
+	Comment          string  `xml:"comment,omitempty"`
+	Notify           Boolean `xml:"notify"`
+}
+
+func NewSalesOrderAddCommentResponse() *SalesOrderAddCommentResponse {
+	return &SalesOrderAddCommentResponse{}
+}
+
+type SalesOrderAddCommentResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderService) Hold(ctx context.Context, requestBody *SalesOrderHoldRequest) (*SalesOrderHoldResponse, error) {
+	responseBody := NewSalesOrderHoldResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderHoldRequest() *SalesOrderHoldRequest {
+	return &SalesOrderHoldRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderHoldAction,
+		},
+	}
+}
+
+type SalesOrderHoldRequest struct {
+	XMLName xml.Name `xml:"salesOrderHold"`
+
+	SessionID        *Session
+	OrderIncrementID string `xml:"orderIncrementId"`
+}
+
+func NewSalesOrderHoldResponse() *SalesOrderHoldResponse {
+	return &SalesOrderHoldResponse{}
+}
+
+type SalesOrderHoldResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderService) Unhold(ctx context.Context, requestBody *SalesOrderUnholdRequest) (*SalesOrderUnholdResponse, error) {
+	responseBody := NewSalesOrderUnholdResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderUnholdRequest() *SalesOrderUnholdRequest {
+	return &SalesOrderUnholdRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderUnholdAction,
+		},
+	}
+}
+
+type SalesOrderUnholdRequest struct {
+	XMLName xml.Name `xml:"salesOrderUnhold"`
+
+	SessionID        *Session
+	OrderIncrementID string `xml:"orderIncrementId"`
+}
+
+func NewSalesOrderUnholdResponse() *SalesOrderUnholdResponse {
+	return &SalesOrderUnholdResponse{}
+}
+
+type SalesOrderUnholdResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderService) Cancel(ctx context.Context, requestBody *SalesOrderCancelRequest) (*SalesOrderCancelResponse, error) {
+	responseBody := NewSalesOrderCancelResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCancelRequest() *SalesOrderCancelRequest {
+	return &SalesOrderCancelRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCancelAction,
+		},
+	}
+}
+
+type SalesOrderCancelRequest struct {
+	XMLName xml.Name `xml:"salesOrderCancel"`
+
+	SessionID        *Session
+	OrderIncrementID string `xml:"orderIncrementId"`
+}
+
+func NewSalesOrderCancelResponse() *SalesOrderCancelResponse {
+	return &SalesOrderCancelResponse{}
+}
+
+type SalesOrderCancelResponse struct {
+	Result bool `xml:"result"`
+}
+
+// OrderItemIDQty is used by the invoice, shipment and credit memo services to
+// specify the quantity of an order item
+type OrderItemIDQty struct {
+	OrderItemID int     `xml:"order_item_id"`
+	Qty         Decimal `xml:"qty"`
+}
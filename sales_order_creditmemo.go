@@ -0,0 +1,388 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	salesOrderCreditmemoListAction       = "salesOrderCreditmemoList"
+	salesOrderCreditmemoInfoAction       = "salesOrderCreditmemoInfo"
+	salesOrderCreditmemoCreateAction     = "salesOrderCreditmemoCreate"
+	salesOrderCreditmemoAddCommentAction = "salesOrderCreditmemoAddComment"
+	salesOrderCreditmemoCancelAction     = "salesOrderCreditmemoCancel"
+)
+
+func NewSalesOrderCreditmemoService(client *Client) *SalesOrderCreditmemoService {
+	return &SalesOrderCreditmemoService{Client: client}
+}
+
+type SalesOrderCreditmemoService struct {
+	Client *Client
+}
+
+func (s *SalesOrderCreditmemoService) List(ctx context.Context, requestBody *SalesOrderCreditmemoListRequest) (*SalesOrderCreditmemoListResponse, error) {
+	responseBody := NewSalesOrderCreditmemoListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCreditmemoListRequest() *SalesOrderCreditmemoListRequest {
+	return &SalesOrderCreditmemoListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCreditmemoListAction,
+		},
+	}
+}
+
+type SalesOrderCreditmemoListRequest struct {
+	XMLName xml.Name `xml:"salesOrderCreditmemoList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewSalesOrderCreditmemoListResponse() *SalesOrderCreditmemoListResponse {
+	return &SalesOrderCreditmemoListResponse{}
+}
+
+type SalesOrderCreditmemoListResponse struct {
+	Result SalesOrderCreditmemoEntityArray `xml:"result"`
+}
+
+type SalesOrderCreditmemoEntityArray struct {
+	Items []SalesOrderCreditmemoEntity `xml:"item"`
+}
+
+func (s *SalesOrderCreditmemoService) Info(ctx context.Context, requestBody *SalesOrderCreditmemoInfoRequest) (*SalesOrderCreditmemoInfoResponse, error) {
+	responseBody := NewSalesOrderCreditmemoInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCreditmemoInfoRequest() *SalesOrderCreditmemoInfoRequest {
+	return &SalesOrderCreditmemoInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCreditmemoInfoAction,
+		},
+	}
+}
+
+type SalesOrderCreditmemoInfoRequest struct {
+	XMLName xml.Name `xml:"salesOrderCreditmemoInfo"`
+
+	SessionID             *Session
+	CreditmemoIncrementID string `xml:"creditmemoIncrementId"`
+}
+
+func NewSalesOrderCreditmemoInfoResponse() *SalesOrderCreditmemoInfoResponse {
+	return &SalesOrderCreditmemoInfoResponse{}
+}
+
+type SalesOrderCreditmemoInfoResponse struct {
+	Result SalesOrderCreditmemoEntity `xml:"result"`
+}
+
+// salesOrderCreditmemoList only fills a subset of these fields
+type SalesOrderCreditmemoEntity struct {
+	UpdatedAt                TimeWithoutTimeZone                 `xml:"updated_at"`
+	CreatedAt                TimeWithoutTimeZone                 `xml:"created_at"`
+	IncrementID              string                              `xml:"increment_id"`
+	TransactionID            string                              `xml:"transaction_id"`
+	GlobalCurrencyCode       string                              `xml:"global_currency_code"`
+	BaseCurrencyCode         string                              `xml:"base_currency_code"`
+	OrderCurrencyCode        string                              `xml:"order_currency_code"`
+	StoreCurrencyCode        string                              `xml:"store_currency_code"`
+	InvoiceID                int                                 `xml:"invoice_id"`
+	BillingAddressID         int                                 `xml:"billing_address_id"`
+	ShippingAddressID        int                                 `xml:"shipping_address_id"`
+	State                    string                              `xml:"state"`
+	CreditmemoStatus         string                              `xml:"creditmemo_status"`
+	EmailSent                Boolean                             `xml:"email_sent"`
+	OrderID                  int                                 `xml:"order_id"`
+	TaxAmount                Decimal                             `xml:"tax_amount"`
+	ShippingTaxAmount        Decimal                             `xml:"shipping_tax_amount"`
+	BaseTaxAmount            Decimal                             `xml:"base_tax_amount"`
+	BaseAdjustmentPositive   Decimal                             `xml:"base_adjustment_positive"`
+	BaseGrandTotal           Decimal                             `xml:"base_grand_total"`
+	Adjustment               Decimal                             `xml:"adjustment"`
+	Subtotal                 Decimal                             `xml:"subtotal"`
+	DiscountAmount           Decimal                             `xml:"discount_amount"`
+	BaseSubtotal             Decimal                             `xml:"base_subtotal"`
+	BaseAdjustment           Decimal                             `xml:"base_adjustment"`
+	BaseToGlobalRate         Decimal                             `xml:"base_to_global_rate"`
+	StoreToBaseRate          Decimal                             `xml:"store_to_base_rate"`
+	BaseShippingAmount       Decimal                             `xml:"base_shipping_amount"`
+	AdjustmentNegative       Decimal                             `xml:"adjustment_negative"`
+	SubtotalInclTax          Decimal                             `xml:"subtotal_incl_tax"`
+	ShippingAmount           Decimal                             `xml:"shipping_amount"`
+	BaseSubtotalInclTax      Decimal                             `xml:"base_subtotal_incl_tax"`
+	BaseAdjustmentNegative   Decimal                             `xml:"base_adjustment_negative"`
+	GrandTotal               Decimal                             `xml:"grand_total"`
+	BaseDiscountAmount       Decimal                             `xml:"base_discount_amount"`
+	BaseToOrderRate          Decimal                             `xml:"base_to_order_rate"`
+	StoreToOrderRate         Decimal                             `xml:"store_to_order_rate"`
+	BaseShippingTaxAmount    Decimal                             `xml:"base_shipping_tax_amount"`
+	AdjustmentPositive       Decimal                             `xml:"adjustment_positive"`
+	StoreID                  int                                 `xml:"store_id"`
+	HiddenTaxAmount          Decimal                             `xml:"hidden_tax_amount"`
+	BaseHiddenTaxAmount      Decimal                             `xml:"base_hidden_tax_amount"`
+	ShippingHiddenTaxAmount  Decimal                             `xml:"shipping_hidden_tax_amount"`
+	BaseShippingHiddenTaxAmt Decimal                             `xml:"base_shipping_hidden_tax_amnt"`
+	ShippingInclTax          Decimal                             `xml:"shipping_incl_tax"`
+	BaseShippingInclTax      Decimal                             `xml:"base_shipping_incl_tax"`
+	CreditmemoID             int                                 `xml:"creditmemo_id"`
+	Items                    []SalesOrderCreditmemoItemEntity    `xml:"items>item"`
+	Comments                 []SalesOrderCreditmemoCommentEntity `xml:"comments>item"`
+}
+
+type SalesOrderCreditmemoItemEntity struct {
+	ItemID                      int     `xml:"item_id"`
+	ParentID                    int     `xml:"parent_id"`
+	WeeeTaxAppliedRowAmount     Decimal `xml:"weee_tax_applied_row_amount"`
+	BasePrice                   Decimal `xml:"base_price"`
+	BaseWeeeTaxRowDisposition   Decimal `xml:"base_weee_tax_row_disposition"`
+	TaxAmount                   Decimal `xml:"tax_amount"`
+	BaseWeeeTaxAppliedAmount    Decimal `xml:"base_weee_tax_applied_amount"`
+	WeeeTaxRowDisposition       Decimal `xml:"weee_tax_row_disposition"`
+	BaseRowTotal                Decimal `xml:"base_row_total"`
+	DiscountAmount              Decimal `xml:"discount_amount"`
+	RowTotal                    Decimal `xml:"row_total"`
+	WeeeTaxAppliedAmount        Decimal `xml:"weee_tax_applied_amount"`
+	BaseDiscountAmount          Decimal `xml:"base_discount_amount"`
+	BaseWeeeTaxDisposition      Decimal `xml:"base_weee_tax_disposition"`
+	PriceInclTax                Decimal `xml:"price_incl_tax"`
+	BaseTaxAmount               Decimal `xml:"base_tax_amount"`
+	WeeeTaxDisposition          Decimal `xml:"weee_tax_disposition"`
+	BasePriceInclTax            Decimal `xml:"base_price_incl_tax"`
+	Qty                         Decimal `xml:"qty"`
+	BaseCost                    Decimal `xml:"base_cost"`
+	BaseWeeeTaxAppliedRowAmount Decimal `xml:"base_weee_tax_applied_row_amount"`
+	Price                       Decimal `xml:"price"`
+	BaseRowTotalInclTax         Decimal `xml:"base_row_total_incl_tax"`
+	RowTotalInclTax             Decimal `xml:"row_total_incl_tax"`
+	ProductID                   int     `xml:"product_id"`
+	OrderItemID                 int     `xml:"order_item_id"`
+	AdditionalData              string  `xml:"additional_data"`
+	Description                 string  `xml:"description"`
+	WeeeTaxApplied              string  `xml:"weee_tax_applied"`
+	Sku                         string  `xml:"sku"`
+	Name                        string  `xml:"name"`
+	HiddenTaxAmount             Decimal `xml:"hidden_tax_amount"`
+	BaseHiddenTaxAmount         Decimal `xml:"base_hidden_tax_amount"`
+}
+
+type SalesOrderCreditmemoCommentEntity struct {
+	ParentID           int                 `xml:"parent_id"`
+	CreatedAt          TimeWithoutTimeZone `xml:"created_at"`
+	Comment            string              `xml:"comment"`
+	IsCustomerNotified Boolean             `xml:"is_customer_notified"`
+	CommentID          int                 `xml:"comment_id"`
+}
+
+func (s *SalesOrderCreditmemoService) Create(ctx context.Context, requestBody *SalesOrderCreditmemoCreateRequest) (*SalesOrderCreditmemoCreateResponse, error) {
+	responseBody := NewSalesOrderCreditmemoCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCreditmemoCreateRequest() *SalesOrderCreditmemoCreateRequest {
+	return &SalesOrderCreditmemoCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCreditmemoCreateAction,
+		},
+		CreditmemoData: &SalesOrderCreditmemoData{},
+	}
+}
+
+// AddItem refunds qty of the order item. When no items are added the whole
+// order is refunded.
+func (req *SalesOrderCreditmemoCreateRequest) AddItem(item SalesOrderItemEntity, qty Decimal, backToStock bool) *SalesOrderCreditmemoCreateRequest {
+	req.CreditmemoData.Qtys = append(req.CreditmemoData.Qtys, SalesOrderCreditmemoItemQty{
+		OrderItemID: item.ItemID,
+		Qty:         qty,
+		BackToStock: Boolean(backToStock),
+	})
+	return req
+}
+
+type SalesOrderCreditmemoCreateRequest struct {
+	XMLName xml.Name `xml:"salesOrderCreditmemoCreate"`
+
+	SessionID *Session
+	// The API names this creditmemoIncrementId but expects the order increment
+	// id
+	OrderIncrementID          string                    `xml:"creditmemoIncrementId"`
+	CreditmemoData            *SalesOrderCreditmemoData `xml:"creditmemoData,omitempty"`
+	Comment                   string                    `xml:"comment,omitempty"`
+	NotifyCustomer            Boolean                   `xml:"notifyCustomer"`
+	IncludeComment            Boolean                   `xml:"includeComment"`
+	RefundToStoreCreditAmount Decimal                   `xml:"refundToStoreCreditAmount,omitempty"`
+}
+
+// Amounts left empty are not sent so Magento uses its defaults (e.g. the full
+// shipping amount)
+type SalesOrderCreditmemoData struct {
+	Qtys               SalesOrderCreditmemoItemQtyArray `xml:"qtys,omitempty"`
+	ShippingAmount     Decimal                          `xml:"shipping_amount,omitempty"`
+	AdjustmentPositive Decimal                          `xml:"adjustment_positive,omitempty"`
+	AdjustmentNegative Decimal                          `xml:"adjustment_negative,omitempty"`
+}
+
+type SalesOrderCreditmemoItemQty struct {
+	OrderItemID int     `xml:"order_item_id"`
+	Qty         Decimal `xml:"qty"`
+	// Not part of the stock Magento schema: items are only returned to stock
+	// when "Auto Return" is configured or an extension handles this flag
+	BackToStock Boolean `xml:"back_to_stock,omitempty"`
+}
+
+func NewSalesOrderCreditmemoCreateResponse() *SalesOrderCreditmemoCreateResponse {
+	return &SalesOrderCreditmemoCreateResponse{}
+}
+
+type SalesOrderCreditmemoCreateResponse struct {
+	// Increment id of the created credit memo
+	Result string `xml:"result"`
+}
+
+func (s *SalesOrderCreditmemoService) AddComment(ctx context.Context, requestBody *SalesOrderCreditmemoAddCommentRequest) (*SalesOrderCreditmemoAddCommentResponse, error) {
+	responseBody := NewSalesOrderCreditmemoAddCommentResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCreditmemoAddCommentRequest() *SalesOrderCreditmemoAddCommentRequest {
+	return &SalesOrderCreditmemoAddCommentRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCreditmemoAddCommentAction,
+		},
+	}
+}
+
+type SalesOrderCreditmemoAddCommentRequest struct {
+	XMLName xml.Name `xml:"salesOrderCreditmemoAddComment"`
+
+	SessionID             *Session
+	CreditmemoIncrementID string  `xml:"creditmemoIncrementId"`
+	Comment               string  `xml:"comment"`
+	NotifyCustomer        Boolean `xml:"notifyCustomer"`
+	IncludeComment        Boolean `xml:"includeComment"`
+}
+
+func NewSalesOrderCreditmemoAddCommentResponse() *SalesOrderCreditmemoAddCommentResponse {
+	return &SalesOrderCreditmemoAddCommentResponse{}
+}
+
+type SalesOrderCreditmemoAddCommentResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderCreditmemoService) Cancel(ctx context.Context, requestBody *SalesOrderCreditmemoCancelRequest) (*SalesOrderCreditmemoCancelResponse, error) {
+	responseBody := NewSalesOrderCreditmemoCancelResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderCreditmemoCancelRequest() *SalesOrderCreditmemoCancelRequest {
+	return &SalesOrderCreditmemoCancelRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderCreditmemoCancelAction,
+		},
+	}
+}
+
+type SalesOrderCreditmemoCancelRequest struct {
+	XMLName xml.Name `xml:"salesOrderCreditmemoCancel"`
+
+	SessionID             *Session
+	CreditmemoIncrementID string `xml:"creditmemoIncrementId"`
+}
+
+func NewSalesOrderCreditmemoCancelResponse() *SalesOrderCreditmemoCancelResponse {
+	return &SalesOrderCreditmemoCancelResponse{}
+}
+
+type SalesOrderCreditmemoCancelResponse struct {
+	Result bool `xml:"result"`
+}
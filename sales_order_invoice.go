@@ -0,0 +1,433 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	salesOrderInvoiceListAction       = "salesOrderInvoiceList"
+	salesOrderInvoiceInfoAction       = "salesOrderInvoiceInfo"
+	salesOrderInvoiceCreateAction     = "salesOrderInvoiceCreate"
+	salesOrderInvoiceAddCommentAction = "salesOrderInvoiceAddComment"
+	salesOrderInvoiceCaptureAction    = "salesOrderInvoiceCapture"
+	salesOrderInvoiceVoidAction       = "salesOrderInvoiceVoid"
+	salesOrderInvoiceCancelAction     = "salesOrderInvoiceCancel"
+)
+
+func NewSalesOrderInvoiceService(client *Client) *SalesOrderInvoiceService {
+	return &SalesOrderInvoiceService{Client: client}
+}
+
+type SalesOrderInvoiceService struct {
+	Client *Client
+}
+
+func (s *SalesOrderInvoiceService) List(ctx context.Context, requestBody *SalesOrderInvoiceListRequest) (*SalesOrderInvoiceListResponse, error) {
+	responseBody := NewSalesOrderInvoiceListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceListRequest() *SalesOrderInvoiceListRequest {
+	return &SalesOrderInvoiceListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceListAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceListRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewSalesOrderInvoiceListResponse() *SalesOrderInvoiceListResponse {
+	return &SalesOrderInvoiceListResponse{}
+}
+
+type SalesOrderInvoiceListResponse struct {
+	Result SalesOrderInvoiceEntityArray `xml:"result"`
+}
+
+type SalesOrderInvoiceEntityArray struct {
+	Items []SalesOrderInvoiceEntity `xml:"item"`
+}
+
+func (s *SalesOrderInvoiceService) Info(ctx context.Context, requestBody *SalesOrderInvoiceInfoRequest) (*SalesOrderInvoiceInfoResponse, error) {
+	responseBody := NewSalesOrderInvoiceInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceInfoRequest() *SalesOrderInvoiceInfoRequest {
+	return &SalesOrderInvoiceInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceInfoAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceInfoRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceInfo"`
+
+	SessionID          *Session
+	InvoiceIncrementID string `xml:"invoiceIncrementId"`
+}
+
+func NewSalesOrderInvoiceInfoResponse() *SalesOrderInvoiceInfoResponse {
+	return &SalesOrderInvoiceInfoResponse{}
+}
+
+type SalesOrderInvoiceInfoResponse struct {
+	Result SalesOrderInvoiceEntity `xml:"result"`
+}
+
+// salesOrderInvoiceList only fills a subset of these fields
+type SalesOrderInvoiceEntity struct {
+	IncrementID        string                           `xml:"increment_id"`
+	ParentID           int                              `xml:"parent_id"`
+	StoreID            int                              `xml:"store_id"`
+	CreatedAt          TimeWithoutTimeZone              `xml:"created_at"`
+	UpdatedAt          TimeWithoutTimeZone              `xml:"updated_at"`
+	IsActive           Boolean                          `xml:"is_active"`
+	GlobalCurrencyCode string                           `xml:"global_currency_code"`
+	BaseCurrencyCode   string                           `xml:"base_currency_code"`
+	StoreCurrencyCode  string                           `xml:"store_currency_code"`
+	OrderCurrencyCode  string                           `xml:"order_currency_code"`
+	StoreToBaseRate    Decimal                          `xml:"store_to_base_rate"`
+	StoreToOrderRate   Decimal                          `xml:"store_to_order_rate"`
+	BaseToGlobalRate   Decimal                          `xml:"base_to_global_rate"`
+	BaseToOrderRate    Decimal                          `xml:"base_to_order_rate"`
+	Subtotal           Decimal                          `xml:"subtotal"`
+	BaseSubtotal       Decimal                          `xml:"base_subtotal"`
+	BaseGrandTotal     Decimal                          `xml:"base_grand_total"`
+	GrandTotal         Decimal                          `xml:"grand_total"`
+	BillingAddressID   int                              `xml:"billing_address_id"`
+	BillingFirstname   string                           `xml:"billing_firstname"`
+	BillingLastname    string                           `xml:"billing_lastname"`
+	OrderID            int                              `xml:"order_id"`
+	OrderIncrementID   string                           `xml:"order_increment_id"`
+	OrderCreatedAt     TimeWithoutTimeZone              `xml:"order_created_at"`
+	State              string                           `xml:"state"`
+	CanVoidFlag        Boolean                          `xml:"can_void_flag"`
+	InvoiceID          int                              `xml:"invoice_id"`
+	Items              []SalesOrderInvoiceItemEntity    `xml:"items>item"`
+	Comments           []SalesOrderInvoiceCommentEntity `xml:"comments>item"`
+}
+
+type SalesOrderInvoiceItemEntity struct {
+	IncrementID                 string              `xml:"increment_id"`
+	ParentID                    int                 `xml:"parent_id"`
+	CreatedAt                   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt                   TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive                    Boolean             `xml:"is_active"`
+	WeeeTaxApplied              string              `xml:"weee_tax_applied"`
+	Qty                         Decimal             `xml:"qty"`
+	Cost                        Decimal             `xml:"cost"`
+	Price                       Decimal             `xml:"price"`
+	TaxAmount                   Decimal             `xml:"tax_amount"`
+	RowTotal                    Decimal             `xml:"row_total"`
+	BasePrice                   Decimal             `xml:"base_price"`
+	BaseTaxAmount               Decimal             `xml:"base_tax_amount"`
+	BaseRowTotal                Decimal             `xml:"base_row_total"`
+	BaseWeeeTaxAppliedAmount    Decimal             `xml:"base_weee_tax_applied_amount"`
+	BaseWeeeTaxAppliedRowAmount Decimal             `xml:"base_weee_tax_applied_row_amount"`
+	WeeeTaxAppliedAmount        Decimal             `xml:"weee_tax_applied_amount"`
+	WeeeTaxAppliedRowAmount     Decimal             `xml:"weee_tax_applied_row_amount"`
+	WeeeTaxDisposition          Decimal             `xml:"weee_tax_disposition"`
+	WeeeTaxRowDisposition       Decimal             `xml:"weee_tax_row_disposition"`
+	BaseWeeeTaxDisposition      Decimal             `xml:"base_weee_tax_disposition"`
+	BaseWeeeTaxRowDisposition   Decimal             `xml:"base_weee_tax_row_disposition"`
+	Sku                         string              `xml:"sku"`
+	Name                        string              `xml:"name"`
+	OrderItemID                 int                 `xml:"order_item_id"`
+	ProductID                   int                 `xml:"product_id"`
+	ItemID                      int                 `xml:"item_id"`
+}
+
+type SalesOrderInvoiceCommentEntity struct {
+	IncrementID        string              `xml:"increment_id"`
+	ParentID           int                 `xml:"parent_id"`
+	CreatedAt          TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt          TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive           Boolean             `xml:"is_active"`
+	Comment            string              `xml:"comment"`
+	IsCustomerNotified Boolean             `xml:"is_customer_notified"`
+	CommentID          int                 `xml:"comment_id"`
+}
+
+func (s *SalesOrderInvoiceService) Create(ctx context.Context, requestBody *SalesOrderInvoiceCreateRequest) (*SalesOrderInvoiceCreateResponse, error) {
+	responseBody := NewSalesOrderInvoiceCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceCreateRequest() *SalesOrderInvoiceCreateRequest {
+	return &SalesOrderInvoiceCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceCreateAction,
+		},
+	}
+}
+
+// AddItem invoices qty of the order item. When no items are added the whole
+// order is invoiced.
+func (req *SalesOrderInvoiceCreateRequest) AddItem(item SalesOrderItemEntity, qty Decimal) *SalesOrderInvoiceCreateRequest {
+	req.ItemsQty = append(req.ItemsQty, OrderItemIDQty{OrderItemID: item.ItemID, Qty: qty})
+	return req
+}
+
+type SalesOrderInvoiceCreateRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceCreate"`
+
+	SessionID *Session
+	// The API names this invoiceIncrementId but expects the order increment id
+	OrderIncrementID string              `xml:"invoiceIncrementId"`
+	ItemsQty         OrderItemIDQtyArray `xml:"itemsQty,omitempty"`
+	Comment          string              `xml:"comment,omitempty"`
+	Email            Boolean             `xml:"email"`
+	IncludeComment   Boolean             `xml:"includeComment"`
+}
+
+func NewSalesOrderInvoiceCreateResponse() *SalesOrderInvoiceCreateResponse {
+	return &SalesOrderInvoiceCreateResponse{}
+}
+
+type SalesOrderInvoiceCreateResponse struct {
+	// Increment id of the created invoice
+	Result string `xml:"result"`
+}
+
+func (s *SalesOrderInvoiceService) AddComment(ctx context.Context, requestBody *SalesOrderInvoiceAddCommentRequest) (*SalesOrderInvoiceAddCommentResponse, error) {
+	responseBody := NewSalesOrderInvoiceAddCommentResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceAddCommentRequest() *SalesOrderInvoiceAddCommentRequest {
+	return &SalesOrderInvoiceAddCommentRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceAddCommentAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceAddCommentRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceAddComment"`
+
+	SessionID          *Session
+	InvoiceIncrementID string  `xml:"invoiceIncrementId"`
+	Comment            string  `xml:"comment"`
+	Email              Boolean `xml:"email"`
+	IncludeComment     Boolean `xml:"includeComment"`
+}
+
+func NewSalesOrderInvoiceAddCommentResponse() *SalesOrderInvoiceAddCommentResponse {
+	return &SalesOrderInvoiceAddCommentResponse{}
+}
+
+type SalesOrderInvoiceAddCommentResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderInvoiceService) Capture(ctx context.Context, requestBody *SalesOrderInvoiceCaptureRequest) (*SalesOrderInvoiceCaptureResponse, error) {
+	responseBody := NewSalesOrderInvoiceCaptureResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceCaptureRequest() *SalesOrderInvoiceCaptureRequest {
+	return &SalesOrderInvoiceCaptureRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceCaptureAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceCaptureRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceCapture"`
+
+	SessionID          *Session
+	InvoiceIncrementID string `xml:"invoiceIncrementId"`
+}
+
+func NewSalesOrderInvoiceCaptureResponse() *SalesOrderInvoiceCaptureResponse {
+	return &SalesOrderInvoiceCaptureResponse{}
+}
+
+type SalesOrderInvoiceCaptureResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderInvoiceService) Void(ctx context.Context, requestBody *SalesOrderInvoiceVoidRequest) (*SalesOrderInvoiceVoidResponse, error) {
+	responseBody := NewSalesOrderInvoiceVoidResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceVoidRequest() *SalesOrderInvoiceVoidRequest {
+	return &SalesOrderInvoiceVoidRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceVoidAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceVoidRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceVoid"`
+
+	SessionID          *Session
+	InvoiceIncrementID string `xml:"invoiceIncrementId"`
+}
+
+func NewSalesOrderInvoiceVoidResponse() *SalesOrderInvoiceVoidResponse {
+	return &SalesOrderInvoiceVoidResponse{}
+}
+
+type SalesOrderInvoiceVoidResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderInvoiceService) Cancel(ctx context.Context, requestBody *SalesOrderInvoiceCancelRequest) (*SalesOrderInvoiceCancelResponse, error) {
+	responseBody := NewSalesOrderInvoiceCancelResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderInvoiceCancelRequest() *SalesOrderInvoiceCancelRequest {
+	return &SalesOrderInvoiceCancelRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderInvoiceCancelAction,
+		},
+	}
+}
+
+type SalesOrderInvoiceCancelRequest struct {
+	XMLName xml.Name `xml:"salesOrderInvoiceCancel"`
+
+	SessionID          *Session
+	InvoiceIncrementID string `xml:"invoiceIncrementId"`
+}
+
+func NewSalesOrderInvoiceCancelResponse() *SalesOrderInvoiceCancelResponse {
+	return &SalesOrderInvoiceCancelResponse{}
+}
+
+type SalesOrderInvoiceCancelResponse struct {
+	Result bool `xml:"result"`
+}
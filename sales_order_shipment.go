@@ -0,0 +1,473 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	salesOrderShipmentListAction        = "salesOrderShipmentList"
+	salesOrderShipmentInfoAction        = "salesOrderShipmentInfo"
+	salesOrderShipmentCreateAction      = "salesOrderShipmentCreate"
+	salesOrderShipmentAddCommentAction  = "salesOrderShipmentAddComment"
+	salesOrderShipmentAddTrackAction    = "salesOrderShipmentAddTrack"
+	salesOrderShipmentRemoveTrackAction = "salesOrderShipmentRemoveTrack"
+	salesOrderShipmentSendInfoAction    = "salesOrderShipmentSendInfo"
+	salesOrderShipmentGetCarriersAction = "salesOrderShipmentGetCarriers"
+)
+
+func NewSalesOrderShipmentService(client *Client) *SalesOrderShipmentService {
+	return &SalesOrderShipmentService{Client: client}
+}
+
+type SalesOrderShipmentService struct {
+	Client *Client
+}
+
+func (s *SalesOrderShipmentService) List(ctx context.Context, requestBody *SalesOrderShipmentListRequest) (*SalesOrderShipmentListResponse, error) {
+	responseBody := NewSalesOrderShipmentListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentListRequest() *SalesOrderShipmentListRequest {
+	return &SalesOrderShipmentListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentListAction,
+		},
+	}
+}
+
+type SalesOrderShipmentListRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentList"`
+
+	SessionID *Session
+	Filters   *Filters `xml:"filters,omitempty"`
+}
+
+func NewSalesOrderShipmentListResponse() *SalesOrderShipmentListResponse {
+	return &SalesOrderShipmentListResponse{}
+}
+
+type SalesOrderShipmentListResponse struct {
+	Result SalesOrderShipmentEntityArray `xml:"result"`
+}
+
+type SalesOrderShipmentEntityArray struct {
+	Items []SalesOrderShipmentEntity `xml:"item"`
+}
+
+func (s *SalesOrderShipmentService) Info(ctx context.Context, requestBody *SalesOrderShipmentInfoRequest) (*SalesOrderShipmentInfoResponse, error) {
+	responseBody := NewSalesOrderShipmentInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentInfoRequest() *SalesOrderShipmentInfoRequest {
+	return &SalesOrderShipmentInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentInfoAction,
+		},
+	}
+}
+
+type SalesOrderShipmentInfoRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentInfo"`
+
+	SessionID           *Session
+	ShipmentIncrementID string `xml:"shipmentIncrementId"`
+}
+
+func NewSalesOrderShipmentInfoResponse() *SalesOrderShipmentInfoResponse {
+	return &SalesOrderShipmentInfoResponse{}
+}
+
+type SalesOrderShipmentInfoResponse struct {
+	Result SalesOrderShipmentEntity `xml:"result"`
+}
+
+// salesOrderShipmentList only fills a subset of these fields
+type SalesOrderShipmentEntity struct {
+	IncrementID       string                            `xml:"increment_id"`
+	ParentID          int                               `xml:"parent_id"`
+	StoreID           int                               `xml:"store_id"`
+	CreatedAt         TimeWithoutTimeZone               `xml:"created_at"`
+	UpdatedAt         TimeWithoutTimeZone               `xml:"updated_at"`
+	IsActive          Boolean                           `xml:"is_active"`
+	ShippingAddressID int                               `xml:"shipping_address_id"`
+	ShippingFirstname string                            `xml:"shipping_firstname"`
+	ShippingLastname  string                            `xml:"shipping_lastname"`
+	OrderID           int                               `xml:"order_id"`
+	OrderIncrementID  string                            `xml:"order_increment_id"`
+	OrderCreatedAt    TimeWithoutTimeZone               `xml:"order_created_at"`
+	TotalQty          Decimal                           `xml:"total_qty"`
+	ShipmentID        int                               `xml:"shipment_id"`
+	Items             []SalesOrderShipmentItemEntity    `xml:"items>item"`
+	Tracks            []SalesOrderShipmentTrackEntity   `xml:"tracks>item"`
+	Comments          []SalesOrderShipmentCommentEntity `xml:"comments>item"`
+}
+
+type SalesOrderShipmentItemEntity struct {
+	IncrementID string              `xml:"increment_id"`
+	ParentID    int                 `xml:"parent_id"`
+	CreatedAt   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt   TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive    Boolean             `xml:"is_active"`
+	Sku         string              `xml:"sku"`
+	Name        string              `xml:"name"`
+	OrderItemID int                 `xml:"order_item_id"`
+	ProductID   int                 `xml:"product_id"`
+	Weight      Decimal             `xml:"weight"`
+	Price       Decimal             `xml:"price"`
+	Qty         Decimal             `xml:"qty"`
+	ItemID      int                 `xml:"item_id"`
+}
+
+type SalesOrderShipmentTrackEntity struct {
+	IncrementID string              `xml:"increment_id"`
+	ParentID    int                 `xml:"parent_id"`
+	CreatedAt   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt   TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive    Boolean             `xml:"is_active"`
+	CarrierCode string              `xml:"carrier_code"`
+	Title       string              `xml:"title"`
+	Number      string              `xml:"number"`
+	OrderID     int                 `xml:"order_id"`
+	TrackID     int                 `xml:"track_id"`
+}
+
+type SalesOrderShipmentCommentEntity struct {
+	IncrementID        string              `xml:"increment_id"`
+	ParentID           int                 `xml:"parent_id"`
+	CreatedAt          TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt          TimeWithoutTimeZone `xml:"updated_at"`
+	IsActive           Boolean             `xml:"is_active"`
+	Comment            string              `xml:"comment"`
+	IsCustomerNotified Boolean             `xml:"is_customer_notified"`
+	CommentID          int                 `xml:"comment_id"`
+}
+
+func (s *SalesOrderShipmentService) Create(ctx context.Context, requestBody *SalesOrderShipmentCreateRequest) (*SalesOrderShipmentCreateResponse, error) {
+	responseBody := NewSalesOrderShipmentCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentCreateRequest() *SalesOrderShipmentCreateRequest {
+	return &SalesOrderShipmentCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentCreateAction,
+		},
+	}
+}
+
+// AddItem ships qty of the order item. When no items are added the whole
+// order is shipped.
+func (req *SalesOrderShipmentCreateRequest) AddItem(item SalesOrderItemEntity, qty Decimal) *SalesOrderShipmentCreateRequest {
+	req.ItemsQty = append(req.ItemsQty, OrderItemIDQty{OrderItemID: item.ItemID, Qty: qty})
+	return req
+}
+
+type SalesOrderShipmentCreateRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentCreate"`
+
+	SessionID        *Session
+	OrderIncrementID string              `xml:"orderIncrementId"`
+	ItemsQty         OrderItemIDQtyArray `xml:"itemsQty,omitempty"`
+	Comment          string              `xml:"comment,omitempty"`
+	Email            Boolean             `xml:"email"`
+	IncludeComment   Boolean             `xml:"includeComment"`
+}
+
+func NewSalesOrderShipmentCreateResponse() *SalesOrderShipmentCreateResponse {
+	return &SalesOrderShipmentCreateResponse{}
+}
+
+type SalesOrderShipmentCreateResponse struct {
+	// Increment id of the created shipment
+	Result string `xml:"result"`
+}
+
+func (s *SalesOrderShipmentService) AddComment(ctx context.Context, requestBody *SalesOrderShipmentAddCommentRequest) (*SalesOrderShipmentAddCommentResponse, error) {
+	responseBody := NewSalesOrderShipmentAddCommentResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentAddCommentRequest() *SalesOrderShipmentAddCommentRequest {
+	return &SalesOrderShipmentAddCommentRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentAddCommentAction,
+		},
+	}
+}
+
+type SalesOrderShipmentAddCommentRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentAddComment"`
+
+	SessionID           *Session
+	ShipmentIncrementID string  `xml:"shipmentIncrementId"`
+	Comment             string  `xml:"comment"`
+	Email               Boolean `xml:"email"`
+	IncludeInEmail      Boolean `xml:"includeInEmail"`
+}
+
+func NewSalesOrderShipmentAddCommentResponse() *SalesOrderShipmentAddCommentResponse {
+	return &SalesOrderShipmentAddCommentResponse{}
+}
+
+type SalesOrderShipmentAddCommentResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderShipmentService) AddTrack(ctx context.Context, requestBody *SalesOrderShipmentAddTrackRequest) (*SalesOrderShipmentAddTrackResponse, error) {
+	responseBody := NewSalesOrderShipmentAddTrackResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentAddTrackRequest() *SalesOrderShipmentAddTrackRequest {
+	return &SalesOrderShipmentAddTrackRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentAddTrackAction,
+		},
+	}
+}
+
+type SalesOrderShipmentAddTrackRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentAddTrack"`
+
+	SessionID           *Session
+	ShipmentIncrementID string `xml:"shipmentIncrementId"`
+	// Carrier code as returned by GetCarriers, e.g. "ups", "dhl" or "custom"
+	Carrier     string `xml:"carrier"`
+	Title       string `xml:"title"`
+	TrackNumber string `xml:"trackNumber"`
+}
+
+func NewSalesOrderShipmentAddTrackResponse() *SalesOrderShipmentAddTrackResponse {
+	return &SalesOrderShipmentAddTrackResponse{}
+}
+
+type SalesOrderShipmentAddTrackResponse struct {
+	// ID of the created track
+	Result int `xml:"result"`
+}
+
+func (s *SalesOrderShipmentService) RemoveTrack(ctx context.Context, requestBody *SalesOrderShipmentRemoveTrackRequest) (*SalesOrderShipmentRemoveTrackResponse, error) {
+	responseBody := NewSalesOrderShipmentRemoveTrackResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentRemoveTrackRequest() *SalesOrderShipmentRemoveTrackRequest {
+	return &SalesOrderShipmentRemoveTrackRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentRemoveTrackAction,
+		},
+	}
+}
+
+type SalesOrderShipmentRemoveTrackRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentRemoveTrack"`
+
+	SessionID           *Session
+	ShipmentIncrementID string `xml:"shipmentIncrementId"`
+	TrackID             int    `xml:"trackId"`
+}
+
+func NewSalesOrderShipmentRemoveTrackResponse() *SalesOrderShipmentRemoveTrackResponse {
+	return &SalesOrderShipmentRemoveTrackResponse{}
+}
+
+type SalesOrderShipmentRemoveTrackResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderShipmentService) SendInfo(ctx context.Context, requestBody *SalesOrderShipmentSendInfoRequest) (*SalesOrderShipmentSendInfoResponse, error) {
+	responseBody := NewSalesOrderShipmentSendInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentSendInfoRequest() *SalesOrderShipmentSendInfoRequest {
+	return &SalesOrderShipmentSendInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentSendInfoAction,
+		},
+	}
+}
+
+type SalesOrderShipmentSendInfoRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentSendInfo"`
+
+	SessionID           *Session
+	ShipmentIncrementID string `xml:"shipmentIncrementId"`
+	Comment             string `xml:"comment,omitempty"`
+}
+
+func NewSalesOrderShipmentSendInfoResponse() *SalesOrderShipmentSendInfoResponse {
+	return &SalesOrderShipmentSendInfoResponse{}
+}
+
+type SalesOrderShipmentSendInfoResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *SalesOrderShipmentService) GetCarriers(ctx context.Context, requestBody *SalesOrderShipmentGetCarriersRequest) (*SalesOrderShipmentGetCarriersResponse, error) {
+	responseBody := NewSalesOrderShipmentGetCarriersResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewSalesOrderShipmentGetCarriersRequest() *SalesOrderShipmentGetCarriersRequest {
+	return &SalesOrderShipmentGetCarriersRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: salesOrderShipmentGetCarriersAction,
+		},
+	}
+}
+
+type SalesOrderShipmentGetCarriersRequest struct {
+	XMLName xml.Name `xml:"salesOrderShipmentGetCarriers"`
+
+	SessionID        *Session
+	OrderIncrementID string `xml:"orderIncrementId"`
+}
+
+func NewSalesOrderShipmentGetCarriersResponse() *SalesOrderShipmentGetCarriersResponse {
+	return &SalesOrderShipmentGetCarriersResponse{}
+}
+
+type SalesOrderShipmentGetCarriersResponse struct {
+	// Carrier code (key) and title (value)
+	Result []AssociativeEntity `xml:"result>item"`
+}
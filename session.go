@@ -18,7 +18,7 @@ type SessionService struct {
 	Client *Client
 }
 
-func (s *SessionService) Login(requestBody *LoginRequest, ctx context.Context) (*LoginResponse, error) {
+func (s *SessionService) Login(ctx context.Context, requestBody *LoginRequest) (*LoginResponse, error) {
 	responseBody := NewLoginResponse()
 	response := NewResponse().WithData(responseBody)
 	// requestBody.SessionID = s.Client.GetSession()
@@ -0,0 +1,444 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartCreateAction  = "shoppingCartCreate"
+	shoppingCartInfoAction    = "shoppingCartInfo"
+	shoppingCartTotalsAction  = "shoppingCartTotals"
+	shoppingCartOrderAction   = "shoppingCartOrder"
+	shoppingCartLicenseAction = "shoppingCartLicense"
+)
+
+func NewShoppingCartService(client *Client) *ShoppingCartService {
+	return &ShoppingCartService{Client: client}
+}
+
+type ShoppingCartService struct {
+	Client *Client
+}
+
+func (s *ShoppingCartService) Create(ctx context.Context, requestBody *ShoppingCartCreateRequest) (*ShoppingCartCreateResponse, error) {
+	responseBody := NewShoppingCartCreateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartCreateRequest() *ShoppingCartCreateRequest {
+	return &ShoppingCartCreateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartCreateAction,
+		},
+	}
+}
+
+type ShoppingCartCreateRequest struct {
+	XMLName xml.Name `xml:"shoppingCartCreate"`
+
+	SessionID *Session
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartCreateResponse() *ShoppingCartCreateResponse {
+	return &ShoppingCartCreateResponse{}
+}
+
+type ShoppingCartCreateResponse struct {
+	// ID of the created quote
+	QuoteID int `xml:"quoteId"`
+}
+
+func (s *ShoppingCartService) Info(ctx context.Context, requestBody *ShoppingCartInfoRequest) (*ShoppingCartInfoResponse, error) {
+	responseBody := NewShoppingCartInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartInfoRequest() *ShoppingCartInfoRequest {
+	return &ShoppingCartInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartInfoAction,
+		},
+	}
+}
+
+type ShoppingCartInfoRequest struct {
+	XMLName xml.Name `xml:"shoppingCartInfo"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartInfoResponse() *ShoppingCartInfoResponse {
+	return &ShoppingCartInfoResponse{}
+}
+
+type ShoppingCartInfoResponse struct {
+	Result ShoppingCartInfoEntity `xml:"result"`
+}
+
+type ShoppingCartInfoEntity struct {
+	StoreID                       int                       `xml:"store_id"`
+	CreatedAt                     TimeWithoutTimeZone       `xml:"created_at"`
+	UpdatedAt                     TimeWithoutTimeZone       `xml:"updated_at"`
+	ConvertedAt                   TimeWithoutTimeZone       `xml:"converted_at"`
+	QuoteID                       int                       `xml:"quote_id"`
+	IsActive                      Boolean                   `xml:"is_active"`
+	IsVirtual                     Boolean                   `xml:"is_virtual"`
+	IsMultiShipping               Boolean                   `xml:"is_multi_shipping"`
+	ItemsCount                    int                       `xml:"items_count"`
+	ItemsQty                      Decimal                   `xml:"items_qty"`
+	OrigOrderID                   int                       `xml:"orig_order_id"`
+	StoreToBaseRate               Decimal                   `xml:"store_to_base_rate"`
+	StoreToQuoteRate              Decimal                   `xml:"store_to_quote_rate"`
+	BaseCurrencyCode              string                    `xml:"base_currency_code"`
+	StoreCurrencyCode             string                    `xml:"store_currency_code"`
+	QuoteCurrencyCode             string                    `xml:"quote_currency_code"`
+	GrandTotal                    Decimal                   `xml:"grand_total"`
+	BaseGrandTotal                Decimal                   `xml:"base_grand_total"`
+	CheckoutMethod                string                    `xml:"checkout_method"`
+	CustomerID                    int                       `xml:"customer_id"`
+	CustomerTaxClassID            int                       `xml:"customer_tax_class_id"`
+	CustomerGroupID               int                       `xml:"customer_group_id"`
+	CustomerEmail                 string                    `xml:"customer_email"`
+	CustomerPrefix                string                    `xml:"customer_prefix"`
+	CustomerFirstname             string                    `xml:"customer_firstname"`
+	CustomerMiddlename            string                    `xml:"customer_middlename"`
+	CustomerLastname              string                    `xml:"customer_lastname"`
+	CustomerSuffix                string                    `xml:"customer_suffix"`
+	CustomerNote                  string                    `xml:"customer_note"`
+	CustomerNoteNotify            Boolean                   `xml:"customer_note_notify"`
+	CustomerIsGuest               Boolean                   `xml:"customer_is_guest"`
+	AppliedRuleIDs                string                    `xml:"applied_rule_ids"`
+	ReservedOrderID               string                    `xml:"reserved_order_id"`
+	PasswordHash                  string                    `xml:"password_hash"`
+	CouponCode                    string                    `xml:"coupon_code"`
+	GlobalCurrencyCode            string                    `xml:"global_currency_code"`
+	BaseToGlobalRate              Decimal                   `xml:"base_to_global_rate"`
+	BaseToQuoteRate               Decimal                   `xml:"base_to_quote_rate"`
+	CustomerTaxvat                string                    `xml:"customer_taxvat"`
+	CustomerGender                string                    `xml:"customer_gender"`
+	Subtotal                      Decimal                   `xml:"subtotal"`
+	BaseSubtotal                  Decimal                   `xml:"base_subtotal"`
+	SubtotalWithDiscount          Decimal                   `xml:"subtotal_with_discount"`
+	BaseSubtotalWithDiscount      Decimal                   `xml:"base_subtotal_with_discount"`
+	ExtShippingInfo               string                    `xml:"ext_shipping_info"`
+	GiftMessageID                 int                       `xml:"gift_message_id"`
+	GiftMessage                   string                    `xml:"gift_message"`
+	CustomerBalanceAmountUsed     Decimal                   `xml:"customer_balance_amount_used"`
+	BaseCustomerBalanceAmountUsed Decimal                   `xml:"base_customer_balance_amount_used"`
+	UseCustomerBalance            Boolean                   `xml:"use_customer_balance"`
+	GiftCardsAmount               Decimal                   `xml:"gift_cards_amount"`
+	BaseGiftCardsAmount           Decimal                   `xml:"base_gift_cards_amount"`
+	GiftCardsAmountUsed           Decimal                   `xml:"gift_cards_amount_used"`
+	UseRewardPoints               Boolean                   `xml:"use_reward_points"`
+	RewardPointsBalance           int                       `xml:"reward_points_balance"`
+	BaseRewardCurrencyAmount      Decimal                   `xml:"base_reward_currency_amount"`
+	RewardCurrencyAmount          Decimal                   `xml:"reward_currency_amount"`
+	ShippingAddress               ShoppingCartAddressEntity `xml:"shipping_address"`
+	BillingAddress                ShoppingCartAddressEntity `xml:"billing_address"`
+	Items                         []ShoppingCartItemEntity  `xml:"items>item"`
+	Payment                       ShoppingCartPaymentEntity `xml:"payment"`
+}
+
+type ShoppingCartAddressEntity struct {
+	AddressID           int                 `xml:"address_id"`
+	CreatedAt           TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt           TimeWithoutTimeZone `xml:"updated_at"`
+	CustomerID          int                 `xml:"customer_id"`
+	SaveInAddressBook   Boolean             `xml:"save_in_address_book"`
+	CustomerAddressID   int                 `xml:"customer_address_id"`
+	AddressType         string              `xml:"address_type"`
+	Email               string              `xml:"email"`
+	Prefix              string              `xml:"prefix"`
+	Firstname           string              `xml:"firstname"`
+	Middlename          string              `xml:"middlename"`
+	Lastname            string              `xml:"lastname"`
+	Suffix              string              `xml:"suffix"`
+	Company             string              `xml:"company"`
+	Street              string              `xml:"street"`
+	City                string              `xml:"city"`
+	Region              string              `xml:"region"`
+	RegionID            int                 `xml:"region_id"`
+	Postcode            string              `xml:"postcode"`
+	CountryID           string              `xml:"country_id"`
+	Telephone           string              `xml:"telephone"`
+	Fax                 string              `xml:"fax"`
+	SameAsBilling       Boolean             `xml:"same_as_billing"`
+	FreeShipping        Boolean             `xml:"free_shipping"`
+	ShippingMethod      string              `xml:"shipping_method"`
+	ShippingDescription string              `xml:"shipping_description"`
+	Weight              Decimal             `xml:"weight"`
+}
+
+type ShoppingCartItemEntity struct {
+	ItemID                      int                 `xml:"item_id"`
+	CreatedAt                   TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt                   TimeWithoutTimeZone `xml:"updated_at"`
+	ProductID                   int                 `xml:"product_id"`
+	StoreID                     int                 `xml:"store_id"`
+	ParentItemID                int                 `xml:"parent_item_id"`
+	IsVirtual                   Boolean             `xml:"is_virtual"`
+	Sku                         string              `xml:"sku"`
+	Name                        string              `xml:"name"`
+	Description                 string              `xml:"description"`
+	AppliedRuleIDs              string              `xml:"applied_rule_ids"`
+	AdditionalData              string              `xml:"additional_data"`
+	FreeShipping                Boolean             `xml:"free_shipping"`
+	IsQtyDecimal                Boolean             `xml:"is_qty_decimal"`
+	NoDiscount                  Boolean             `xml:"no_discount"`
+	Weight                      Decimal             `xml:"weight"`
+	Qty                         Decimal             `xml:"qty"`
+	Price                       Decimal             `xml:"price"`
+	BasePrice                   Decimal             `xml:"base_price"`
+	CustomPrice                 Decimal             `xml:"custom_price"`
+	DiscountPercent             Decimal             `xml:"discount_percent"`
+	DiscountAmount              Decimal             `xml:"discount_amount"`
+	BaseDiscountAmount          Decimal             `xml:"base_discount_amount"`
+	TaxPercent                  Decimal             `xml:"tax_percent"`
+	TaxAmount                   Decimal             `xml:"tax_amount"`
+	BaseTaxAmount               Decimal             `xml:"base_tax_amount"`
+	RowTotal                    Decimal             `xml:"row_total"`
+	BaseRowTotal                Decimal             `xml:"base_row_total"`
+	RowTotalWithDiscount        Decimal             `xml:"row_total_with_discount"`
+	RowWeight                   Decimal             `xml:"row_weight"`
+	ProductType                 string              `xml:"product_type"`
+	BaseTaxBeforeDiscount       Decimal             `xml:"base_tax_before_discount"`
+	TaxBeforeDiscount           Decimal             `xml:"tax_before_discount"`
+	OriginalCustomPrice         Decimal             `xml:"original_custom_price"`
+	BaseCost                    Decimal             `xml:"base_cost"`
+	PriceInclTax                Decimal             `xml:"price_incl_tax"`
+	BasePriceInclTax            Decimal             `xml:"base_price_incl_tax"`
+	RowTotalInclTax             Decimal             `xml:"row_total_incl_tax"`
+	BaseRowTotalInclTax         Decimal             `xml:"base_row_total_incl_tax"`
+	GiftMessageID               int                 `xml:"gift_message_id"`
+	GiftMessage                 string              `xml:"gift_message"`
+	GiftMessageAvailable        Boolean             `xml:"gift_message_available"`
+	WeeeTaxApplied              string              `xml:"weee_tax_applied"`
+	WeeeTaxAppliedAmount        Decimal             `xml:"weee_tax_applied_amount"`
+	WeeeTaxAppliedRowAmount     Decimal             `xml:"weee_tax_applied_row_amount"`
+	BaseWeeeTaxAppliedAmount    Decimal             `xml:"base_weee_tax_applied_amount"`
+	BaseWeeeTaxAppliedRowAmount Decimal             `xml:"base_weee_tax_applied_row_amount"`
+	WeeeTaxDisposition          Decimal             `xml:"weee_tax_disposition"`
+	WeeeTaxRowDisposition       Decimal             `xml:"weee_tax_row_disposition"`
+	BaseWeeeTaxDisposition      Decimal             `xml:"base_weee_tax_disposition"`
+	BaseWeeeTaxRowDisposition   Decimal             `xml:"base_weee_tax_row_disposition"`
+	TaxClassID                  int                 `xml:"tax_class_id"`
+}
+
+type ShoppingCartPaymentEntity struct {
+	PaymentID             int                 `xml:"payment_id"`
+	CreatedAt             TimeWithoutTimeZone `xml:"created_at"`
+	UpdatedAt             TimeWithoutTimeZone `xml:"updated_at"`
+	Method                string              `xml:"method"`
+	CcType                string              `xml:"cc_type"`
+	CcNumberEnc           string              `xml:"cc_number_enc"`
+	CcLast4               string              `xml:"cc_last4"`
+	CcCidEnc              string              `xml:"cc_cid_enc"`
+	CcOwner               string              `xml:"cc_owner"`
+	CcExpMonth            string              `xml:"cc_exp_month"`
+	CcExpYear             string              `xml:"cc_exp_year"`
+	CcSsOwner             string              `xml:"cc_ss_owner"`
+	CcSsStartMonth        string              `xml:"cc_ss_start_month"`
+	CcSsStartYear         string              `xml:"cc_ss_start_year"`
+	CcSsIssue             string              `xml:"cc_ss_issue"`
+	PoNumber              string              `xml:"po_number"`
+	AdditionalData        string              `xml:"additional_data"`
+	AdditionalInformation string              `xml:"additional_information"`
+}
+
+func (s *ShoppingCartService) Totals(ctx context.Context, requestBody *ShoppingCartTotalsRequest) (*ShoppingCartTotalsResponse, error) {
+	responseBody := NewShoppingCartTotalsResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartTotalsRequest() *ShoppingCartTotalsRequest {
+	return &ShoppingCartTotalsRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartTotalsAction,
+		},
+	}
+}
+
+type ShoppingCartTotalsRequest struct {
+	XMLName xml.Name `xml:"shoppingCartTotals"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartTotalsResponse() *ShoppingCartTotalsResponse {
+	return &ShoppingCartTotalsResponse{}
+}
+
+type ShoppingCartTotalsResponse struct {
+	Result []ShoppingCartTotalsEntity `xml:"result>item"`
+}
+
+type ShoppingCartTotalsEntity struct {
+	Title  string  `xml:"title"`
+	Amount Decimal `xml:"amount"`
+}
+
+func (s *ShoppingCartService) Order(ctx context.Context, requestBody *ShoppingCartOrderRequest) (*ShoppingCartOrderResponse, error) {
+	responseBody := NewShoppingCartOrderResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartOrderRequest() *ShoppingCartOrderRequest {
+	return &ShoppingCartOrderRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartOrderAction,
+		},
+	}
+}
+
+// Converts the quote into an order. The quote can't be used anymore afterwards.
+type ShoppingCartOrderRequest struct {
+	XMLName xml.Name `xml:"shoppingCartOrder"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+	// IDs of the accepted license agreements, see License()
+	Licenses ArrayOfString `xml:"licenses,omitempty"`
+}
+
+func NewShoppingCartOrderResponse() *ShoppingCartOrderResponse {
+	return &ShoppingCartOrderResponse{}
+}
+
+type ShoppingCartOrderResponse struct {
+	// Increment id of the created order
+	Result string `xml:"result"`
+}
+
+func (s *ShoppingCartService) License(ctx context.Context, requestBody *ShoppingCartLicenseRequest) (*ShoppingCartLicenseResponse, error) {
+	responseBody := NewShoppingCartLicenseResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartLicenseRequest() *ShoppingCartLicenseRequest {
+	return &ShoppingCartLicenseRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartLicenseAction,
+		},
+	}
+}
+
+type ShoppingCartLicenseRequest struct {
+	XMLName xml.Name `xml:"shoppingCartLicense"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"store,omitempty"`
+}
+
+func NewShoppingCartLicenseResponse() *ShoppingCartLicenseResponse {
+	return &ShoppingCartLicenseResponse{}
+}
+
+type ShoppingCartLicenseResponse struct {
+	Result []ShoppingCartLicenseEntity `xml:"result>item"`
+}
+
+type ShoppingCartLicenseEntity struct {
+	AgreementID int     `xml:"agreement_id"`
+	Name        string  `xml:"name"`
+	Content     string  `xml:"content"`
+	IsActive    Boolean `xml:"is_active"`
+	IsHTML      Boolean `xml:"is_html"`
+}
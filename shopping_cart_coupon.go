@@ -0,0 +1,106 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartCouponAddAction    = "shoppingCartCouponAdd"
+	shoppingCartCouponRemoveAction = "shoppingCartCouponRemove"
+)
+
+func (s *ShoppingCartService) CouponAdd(ctx context.Context, requestBody *ShoppingCartCouponAddRequest) (*ShoppingCartCouponAddResponse, error) {
+	responseBody := NewShoppingCartCouponAddResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartCouponAddRequest() *ShoppingCartCouponAddRequest {
+	return &ShoppingCartCouponAddRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartCouponAddAction,
+		},
+	}
+}
+
+type ShoppingCartCouponAddRequest struct {
+	XMLName xml.Name `xml:"shoppingCartCouponAdd"`
+
+	SessionID  *Session
+	QuoteID    int    `xml:"quoteId"`
+	CouponCode string `xml:"couponCode"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartCouponAddResponse() *ShoppingCartCouponAddResponse {
+	return &ShoppingCartCouponAddResponse{}
+}
+
+type ShoppingCartCouponAddResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *ShoppingCartService) CouponRemove(ctx context.Context, requestBody *ShoppingCartCouponRemoveRequest) (*ShoppingCartCouponRemoveResponse, error) {
+	responseBody := NewShoppingCartCouponRemoveResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartCouponRemoveRequest() *ShoppingCartCouponRemoveRequest {
+	return &ShoppingCartCouponRemoveRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartCouponRemoveAction,
+		},
+	}
+}
+
+type ShoppingCartCouponRemoveRequest struct {
+	XMLName xml.Name `xml:"shoppingCartCouponRemove"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartCouponRemoveResponse() *ShoppingCartCouponRemoveResponse {
+	return &ShoppingCartCouponRemoveResponse{}
+}
+
+type ShoppingCartCouponRemoveResponse struct {
+	Result bool `xml:"result"`
+}
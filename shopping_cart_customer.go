@@ -0,0 +1,176 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartCustomerSetAction       = "shoppingCartCustomerSet"
+	shoppingCartCustomerAddressesAction = "shoppingCartCustomerAddresses"
+)
+
+const (
+	CheckoutModeGuest    = "guest"
+	CheckoutModeRegister = "register"
+	CheckoutModeCustomer = "customer"
+
+	AddressModeBilling  = "billing"
+	AddressModeShipping = "shipping"
+)
+
+func (s *ShoppingCartService) CustomerSet(ctx context.Context, requestBody *ShoppingCartCustomerSetRequest) (*ShoppingCartCustomerSetResponse, error) {
+	responseBody := NewShoppingCartCustomerSetResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartCustomerSetRequest() *ShoppingCartCustomerSetRequest {
+	return &ShoppingCartCustomerSetRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartCustomerSetAction,
+		},
+	}
+}
+
+type ShoppingCartCustomerSetRequest struct {
+	XMLName xml.Name `xml:"shoppingCartCustomerSet"`
+
+	SessionID *Session
+	QuoteID   int                         `xml:"quoteId"`
+	Customer  *ShoppingCartCustomerEntity `xml:"customer"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartCustomerSetResponse() *ShoppingCartCustomerSetResponse {
+	return &ShoppingCartCustomerSetResponse{}
+}
+
+type ShoppingCartCustomerSetResponse struct {
+	Result bool `xml:"result"`
+}
+
+type ShoppingCartCustomerEntity struct {
+	// CheckoutModeGuest, CheckoutModeRegister or CheckoutModeCustomer
+	Mode         string `xml:"mode"`
+	CustomerID   int    `xml:"customer_id,omitempty"`
+	Email        string `xml:"email,omitempty"`
+	Firstname    string `xml:"firstname,omitempty"`
+	Lastname     string `xml:"lastname,omitempty"`
+	Password     CDATA  `xml:"password,omitempty"`
+	Confirmation CDATA  `xml:"confirmation,omitempty"`
+	WebsiteID    int    `xml:"website_id,omitempty"`
+	StoreID      int    `xml:"store_id,omitempty"`
+	GroupID      int    `xml:"group_id,omitempty"`
+}
+
+func (s *ShoppingCartService) CustomerAddresses(ctx context.Context, requestBody *ShoppingCartCustomerAddressesRequest) (*ShoppingCartCustomerAddressesResponse, error) {
+	responseBody := NewShoppingCartCustomerAddressesResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartCustomerAddressesRequest() *ShoppingCartCustomerAddressesRequest {
+	return &ShoppingCartCustomerAddressesRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartCustomerAddressesAction,
+		},
+	}
+}
+
+type ShoppingCartCustomerAddressesRequest struct {
+	XMLName xml.Name `xml:"shoppingCartCustomerAddresses"`
+
+	SessionID *Session
+	QuoteID   int                                 `xml:"quoteId"`
+	Customer  []ShoppingCartCustomerAddressEntity `xml:"customer>item"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartCustomerAddressesResponse() *ShoppingCartCustomerAddressesResponse {
+	return &ShoppingCartCustomerAddressesResponse{}
+}
+
+type ShoppingCartCustomerAddressesResponse struct {
+	Result bool `xml:"result"`
+}
+
+// Set AddressID to use an existing address of the customer
+type ShoppingCartCustomerAddressEntity struct {
+	// AddressModeBilling or AddressModeShipping
+	Mode      string `xml:"mode"`
+	AddressID int    `xml:"address_id,omitempty"`
+	Firstname string `xml:"firstname,omitempty"`
+	Lastname  string `xml:"lastname,omitempty"`
+	Company   string `xml:"company,omitempty"`
+	// Lines of the street address separated by a newline
+	Street            string  `xml:"street,omitempty"`
+	City              string  `xml:"city,omitempty"`
+	Region            string  `xml:"region,omitempty"`
+	RegionID          int     `xml:"region_id,omitempty"`
+	Postcode          string  `xml:"postcode,omitempty"`
+	CountryID         string  `xml:"country_id,omitempty"`
+	Telephone         string  `xml:"telephone,omitempty"`
+	Fax               string  `xml:"fax,omitempty"`
+	IsDefaultBilling  Boolean `xml:"is_default_billing"`
+	IsDefaultShipping Boolean `xml:"is_default_shipping"`
+}
+
+// ResolveRegion sets CountryID to the Magento country ID and, for countries
+// with a list of regions, replaces Region by the matching RegionID
+func (a *ShoppingCartCustomerAddressEntity) ResolveRegion(ctx context.Context, client *Client) error {
+	countryID, err := client.CountryID(ctx, a.CountryID)
+	if err != nil {
+		return err
+	}
+	a.CountryID = countryID
+
+	if a.Region == "" || a.RegionID != 0 {
+		return nil
+	}
+
+	regionID, err := client.RegionID(ctx, countryID, a.Region)
+	if err != nil {
+		return err
+	}
+
+	if regionID != 0 {
+		a.RegionID = regionID
+		a.Region = ""
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartPaymentMethodAction = "shoppingCartPaymentMethod"
+	shoppingCartPaymentListAction   = "shoppingCartPaymentList"
+)
+
+func (s *ShoppingCartService) PaymentMethod(ctx context.Context, requestBody *ShoppingCartPaymentMethodRequest) (*ShoppingCartPaymentMethodResponse, error) {
+	responseBody := NewShoppingCartPaymentMethodResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartPaymentMethodRequest() *ShoppingCartPaymentMethodRequest {
+	return &ShoppingCartPaymentMethodRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartPaymentMethodAction,
+		},
+	}
+}
+
+type ShoppingCartPaymentMethodRequest struct {
+	XMLName xml.Name `xml:"shoppingCartPaymentMethod"`
+
+	SessionID *Session
+	QuoteID   int                              `xml:"quoteId"`
+	Method    *ShoppingCartPaymentMethodEntity `xml:"method"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartPaymentMethodResponse() *ShoppingCartPaymentMethodResponse {
+	return &ShoppingCartPaymentMethodResponse{}
+}
+
+type ShoppingCartPaymentMethodResponse struct {
+	Result bool `xml:"result"`
+}
+
+type ShoppingCartPaymentMethodEntity struct {
+	PoNumber string `xml:"po_number,omitempty"`
+	// Code as returned by PaymentList(), e.g. "checkmo"
+	Method     string `xml:"method"`
+	CcCid      CDATA  `xml:"cc_cid,omitempty"`
+	CcOwner    string `xml:"cc_owner,omitempty"`
+	CcNumber   CDATA  `xml:"cc_number,omitempty"`
+	CcType     string `xml:"cc_type,omitempty"`
+	CcExpYear  string `xml:"cc_exp_year,omitempty"`
+	CcExpMonth string `xml:"cc_exp_month,omitempty"`
+}
+
+func (s *ShoppingCartService) PaymentList(ctx context.Context, requestBody *ShoppingCartPaymentListRequest) (*ShoppingCartPaymentListResponse, error) {
+	responseBody := NewShoppingCartPaymentListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartPaymentListRequest() *ShoppingCartPaymentListRequest {
+	return &ShoppingCartPaymentListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartPaymentListAction,
+		},
+	}
+}
+
+type ShoppingCartPaymentListRequest struct {
+	XMLName xml.Name `xml:"shoppingCartPaymentList"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"store,omitempty"`
+}
+
+func NewShoppingCartPaymentListResponse() *ShoppingCartPaymentListResponse {
+	return &ShoppingCartPaymentListResponse{}
+}
+
+type ShoppingCartPaymentListResponse struct {
+	Result []ShoppingCartPaymentMethodResponseEntity `xml:"result>item"`
+}
+
+type ShoppingCartPaymentMethodResponseEntity struct {
+	Code  string `xml:"code"`
+	Title string `xml:"title"`
+	// Credit card type code (key) and name (value)
+	CcTypes []AssociativeEntity `xml:"cc_types>item"`
+}
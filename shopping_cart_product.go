@@ -0,0 +1,269 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartProductAddAction                 = "shoppingCartProductAdd"
+	shoppingCartProductUpdateAction              = "shoppingCartProductUpdate"
+	shoppingCartProductRemoveAction              = "shoppingCartProductRemove"
+	shoppingCartProductListAction                = "shoppingCartProductList"
+	shoppingCartProductMoveToCustomerQuoteAction = "shoppingCartProductMoveToCustomerQuote"
+)
+
+func (s *ShoppingCartService) ProductAdd(ctx context.Context, requestBody *ShoppingCartProductAddRequest) (*ShoppingCartProductAddResponse, error) {
+	responseBody := NewShoppingCartProductAddResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartProductAddRequest() *ShoppingCartProductAddRequest {
+	return &ShoppingCartProductAddRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartProductAddAction,
+		},
+	}
+}
+
+type ShoppingCartProductAddRequest struct {
+	XMLName xml.Name `xml:"shoppingCartProductAdd"`
+
+	SessionID *Session
+	QuoteID   int                         `xml:"quoteId"`
+	Products  []ShoppingCartProductEntity `xml:"products>item"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartProductAddResponse() *ShoppingCartProductAddResponse {
+	return &ShoppingCartProductAddResponse{}
+}
+
+type ShoppingCartProductAddResponse struct {
+	Result bool `xml:"result"`
+}
+
+// Identify the product by either ProductID or Sku
+type ShoppingCartProductEntity struct {
+	ProductID int     `xml:"product_id,omitempty"`
+	Sku       string  `xml:"sku,omitempty"`
+	Qty       Decimal `xml:"qty,omitempty"`
+	// Custom options: option ID (key) and value
+	Options AssociativeArray `xml:"options,omitempty"`
+	// Bundle options: option ID (key) and selection ID (value)
+	BundleOption    AssociativeArray `xml:"bundle_option,omitempty"`
+	BundleOptionQty AssociativeArray `xml:"bundle_option_qty,omitempty"`
+	// Downloadable product link IDs
+	Links ArrayOfString `xml:"links,omitempty"`
+}
+
+func (s *ShoppingCartService) ProductUpdate(ctx context.Context, requestBody *ShoppingCartProductUpdateRequest) (*ShoppingCartProductUpdateResponse, error) {
+	responseBody := NewShoppingCartProductUpdateResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartProductUpdateRequest() *ShoppingCartProductUpdateRequest {
+	return &ShoppingCartProductUpdateRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartProductUpdateAction,
+		},
+	}
+}
+
+type ShoppingCartProductUpdateRequest struct {
+	XMLName xml.Name `xml:"shoppingCartProductUpdate"`
+
+	SessionID *Session
+	QuoteID   int                         `xml:"quoteId"`
+	Products  []ShoppingCartProductEntity `xml:"products>item"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartProductUpdateResponse() *ShoppingCartProductUpdateResponse {
+	return &ShoppingCartProductUpdateResponse{}
+}
+
+type ShoppingCartProductUpdateResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *ShoppingCartService) ProductRemove(ctx context.Context, requestBody *ShoppingCartProductRemoveRequest) (*ShoppingCartProductRemoveResponse, error) {
+	responseBody := NewShoppingCartProductRemoveResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartProductRemoveRequest() *ShoppingCartProductRemoveRequest {
+	return &ShoppingCartProductRemoveRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartProductRemoveAction,
+		},
+	}
+}
+
+type ShoppingCartProductRemoveRequest struct {
+	XMLName xml.Name `xml:"shoppingCartProductRemove"`
+
+	SessionID *Session
+	QuoteID   int                         `xml:"quoteId"`
+	Products  []ShoppingCartProductEntity `xml:"products>item"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartProductRemoveResponse() *ShoppingCartProductRemoveResponse {
+	return &ShoppingCartProductRemoveResponse{}
+}
+
+type ShoppingCartProductRemoveResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *ShoppingCartService) ProductList(ctx context.Context, requestBody *ShoppingCartProductListRequest) (*ShoppingCartProductListResponse, error) {
+	responseBody := NewShoppingCartProductListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartProductListRequest() *ShoppingCartProductListRequest {
+	return &ShoppingCartProductListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartProductListAction,
+		},
+	}
+}
+
+type ShoppingCartProductListRequest struct {
+	XMLName xml.Name `xml:"shoppingCartProductList"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartProductListResponse() *ShoppingCartProductListResponse {
+	return &ShoppingCartProductListResponse{}
+}
+
+type ShoppingCartProductListResponse struct {
+	Result CatalogProductEntityArray `xml:"result"`
+}
+
+func (s *ShoppingCartService) ProductMoveToCustomerQuote(ctx context.Context, requestBody *ShoppingCartProductMoveToCustomerQuoteRequest) (*ShoppingCartProductMoveToCustomerQuoteResponse, error) {
+	responseBody := NewShoppingCartProductMoveToCustomerQuoteResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartProductMoveToCustomerQuoteRequest() *ShoppingCartProductMoveToCustomerQuoteRequest {
+	return &ShoppingCartProductMoveToCustomerQuoteRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartProductMoveToCustomerQuoteAction,
+		},
+	}
+}
+
+// Moves the products from the quote to the shopping cart of the customer
+// that is set on the quote
+type ShoppingCartProductMoveToCustomerQuoteRequest struct {
+	XMLName xml.Name `xml:"shoppingCartProductMoveToCustomerQuote"`
+
+	SessionID *Session
+	QuoteID   int                         `xml:"quoteId"`
+	Products  []ShoppingCartProductEntity `xml:"products>item"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartProductMoveToCustomerQuoteResponse() *ShoppingCartProductMoveToCustomerQuoteResponse {
+	return &ShoppingCartProductMoveToCustomerQuoteResponse{}
+}
+
+type ShoppingCartProductMoveToCustomerQuoteResponse struct {
+	Result bool `xml:"result"`
+}
@@ -0,0 +1,119 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+const (
+	shoppingCartShippingMethodAction = "shoppingCartShippingMethod"
+	shoppingCartShippingListAction   = "shoppingCartShippingList"
+)
+
+func (s *ShoppingCartService) ShippingMethod(ctx context.Context, requestBody *ShoppingCartShippingMethodRequest) (*ShoppingCartShippingMethodResponse, error) {
+	responseBody := NewShoppingCartShippingMethodResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartShippingMethodRequest() *ShoppingCartShippingMethodRequest {
+	return &ShoppingCartShippingMethodRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartShippingMethodAction,
+		},
+	}
+}
+
+type ShoppingCartShippingMethodRequest struct {
+	XMLName xml.Name `xml:"shoppingCartShippingMethod"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Code as returned by ShippingList(), e.g. "flatrate_flatrate"
+	Method string `xml:"method"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartShippingMethodResponse() *ShoppingCartShippingMethodResponse {
+	return &ShoppingCartShippingMethodResponse{}
+}
+
+type ShoppingCartShippingMethodResponse struct {
+	Result bool `xml:"result"`
+}
+
+func (s *ShoppingCartService) ShippingList(ctx context.Context, requestBody *ShoppingCartShippingListRequest) (*ShoppingCartShippingListResponse, error) {
+	responseBody := NewShoppingCartShippingListResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewShoppingCartShippingListRequest() *ShoppingCartShippingListRequest {
+	return &ShoppingCartShippingListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: shoppingCartShippingListAction,
+		},
+	}
+}
+
+// The shipping address has to be set on the quote before the available
+// shipping methods can be listed
+type ShoppingCartShippingListRequest struct {
+	XMLName xml.Name `xml:"shoppingCartShippingList"`
+
+	SessionID *Session
+	QuoteID   int `xml:"quoteId"`
+	// Store ID or code
+	StoreID string `xml:"storeId,omitempty"`
+}
+
+func NewShoppingCartShippingListResponse() *ShoppingCartShippingListResponse {
+	return &ShoppingCartShippingListResponse{}
+}
+
+type ShoppingCartShippingListResponse struct {
+	Result []ShoppingCartShippingMethodEntity `xml:"result>item"`
+}
+
+type ShoppingCartShippingMethodEntity struct {
+	Code              string  `xml:"code"`
+	Carrier           string  `xml:"carrier"`
+	CarrierTitle      string  `xml:"carrier_title"`
+	Method            string  `xml:"method"`
+	MethodTitle       string  `xml:"method_title"`
+	MethodDescription string  `xml:"method_description"`
+	Price             Decimal `xml:"price"`
+}
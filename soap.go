@@ -3,6 +3,8 @@ package magento
 import (
 	"encoding/xml"
 	"net/url"
+	"reflect"
+	"strconv"
 )
 
 func NewRequest() *Request {
@@ -38,6 +40,113 @@ func (r *Request) WithData(data interface{}) *Request {
 	return r
 }
 
+// Operation returns the name of the SOAP operation (the XMLName of the data)
+// or, for SOAP v1 calls, the resource path
+func (r *Request) Operation() string {
+	data := r.Envelope.Body.Data
+	if o, ok := data.(interface{ operation() string }); ok {
+		return o.operation()
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := v.FieldByName("XMLName")
+	if !field.IsValid() {
+		return ""
+	}
+
+	name, _ := field.Interface().(xml.Name)
+	return name.Local
+}
+
+// StoreView returns the store view (StoreView or StoreID field) of the
+// request data, or "" when it has none
+func (r *Request) StoreView() string {
+	v := reflect.Indirect(reflect.ValueOf(r.Envelope.Body.Data))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for _, name := range []string{"StoreView", "StoreID"} {
+		field := v.FieldByName(name)
+		if field.IsValid() && field.Kind() == reflect.String {
+			return field.String()
+		}
+	}
+	return ""
+}
+
+// storeViews returns the store views and store IDs set in the request data
+// and in the structs it points to (e.g. the StoreID of CustomerData)
+func (r *Request) storeViews() []string {
+	v := reflect.Indirect(reflect.ValueOf(r.Envelope.Body.Data))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	views := storeFields(v)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.Struct {
+			views = append(views, storeFields(field.Elem())...)
+		}
+	}
+	return views
+}
+
+func storeFields(v reflect.Value) []string {
+	views := []string{}
+	for _, name := range []string{"StoreView", "StoreID"} {
+		field := v.FieldByName(name)
+		switch field.Kind() {
+		case reflect.String:
+			if field.String() != "" {
+				views = append(views, field.String())
+			}
+		case reflect.Int:
+			if field.Int() != 0 {
+				views = append(views, strconv.FormatInt(field.Int(), 10))
+			}
+		}
+	}
+	return views
+}
+
+// withStoreView returns a copy of the request with the StoreView field of
+// the data set to storeView when it's empty, or the request itself when there
+// is nothing to set. The data of the caller isn't changed.
+func (r *Request) withStoreView(storeView string) *Request {
+	v := reflect.ValueOf(r.Envelope.Body.Data)
+	if storeView == "" || v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return r
+	}
+
+	field := v.Elem().FieldByName("StoreView")
+	if !field.IsValid() || field.Kind() != reflect.String || field.String() != "" {
+		return r
+	}
+
+	data := reflect.New(v.Elem().Type())
+	data.Elem().Set(v.Elem())
+	data.Elem().FieldByName("StoreView").SetString(storeView)
+
+	envelope := *r.Envelope
+	envelope.Body = &Body{Data: data.Interface()}
+	return &Request{Envelope: &envelope, Action: r.Action}
+}
+
+// Idempotent reports whether the request can safely be sent again, see
+// IsIdempotent()
+func (r *Request) Idempotent() bool {
+	if i, ok := r.Envelope.Body.Data.(interface{ idempotent() bool }); ok {
+		return i.idempotent()
+	}
+	return IsIdempotent(r.Operation())
+}
+
 func NewResponse() *Response {
 	return &Response{
 		Envelope: NewEnvelope(),
@@ -46,6 +155,11 @@ func NewResponse() *Response {
 
 type Response struct {
 	Envelope *Envelope `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+
+	// When set, the items of the result are passed to stream while the body
+	// is read instead of being decoded into Envelope, see WithStream()
+	stream   func(d *xml.Decoder, start xml.StartElement) error
+	streamed int
 }
 
 func (r *Response) WithData(data interface{}) *Response {
@@ -0,0 +1,559 @@
+package magento
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SOAP API versions, see Client.SetSoapVersion()
+const (
+	SoapV1 = 1
+	SoapV2 = 2
+)
+
+const (
+	callAction = "call"
+
+	xsiNamespace     = "http://www.w3.org/2001/XMLSchema-instance"
+	xsdNamespace     = "http://www.w3.org/2001/XMLSchema"
+	soapEncNamespace = "http://schemas.xmlsoap.org/soap/encoding/"
+	mapNamespace     = "http://xml.apache.org/xml-soap"
+)
+
+// <call>
+//    <sessionId xsi:type="xsd:string">...</sessionId>
+//    <resourcePath xsi:type="xsd:string">catalog_product.info</resourcePath>
+//    <args xsi:type="SOAP-ENC:Array" SOAP-ENC:arrayType="xsd:anyType[2]">
+//       <item xsi:type="xsd:string">abc</item>
+//       <item xsi:type="ns2:Map">
+//          <item><key xsi:type="xsd:string">name</key><value xsi:type="xsd:string">Abc</value></item>
+//       </item>
+//    </args>
+// </call>
+
+// CallV1 calls a resource of the SOAP v1 API (the api/soap endpoint), e.g.
+// "catalog_product.info". args are the positional arguments of the resource
+// method; maps are sent as SOAP-ENC Maps (PHP associative arrays), slices as
+// SOAP-ENC Arrays and structs like their SOAP v2 counterparts.
+//
+// result can be nil, a *interface{}, *map[string]interface{} or
+// *[]interface{} (see decodeV1Value()) or a pointer to a struct with the
+// same xml tags as the SOAP v2 entities.
+func (c *Client) CallV1(ctx context.Context, resourcePath string, args []interface{}, result interface{}) error {
+	if args == nil {
+		args = []interface{}{}
+	}
+
+	params := Params{
+		{"resourcePath", resourcePath},
+		{"args", args},
+	}
+	value, err := c.doV1(ctx, callAction, params)
+	if err != nil {
+		return err
+	}
+
+	return setV1Result(value, result)
+}
+
+// doV1 sends a SOAP v1 request and returns the decoded return value
+func (c *Client) doV1(ctx context.Context, operation string, params Params) (interface{}, error) {
+	requestBody := &v1Request{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: operation,
+		},
+		Params: params,
+	}
+	session, err := c.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := c.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody := &v1Response{}
+	response := NewResponse().WithData(responseBody)
+
+	// submit the request
+	_, err = c.Do(httpReq, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeV1Value(responseBody.Return), nil
+}
+
+func (c *Client) SetSoapVersion(version int) {
+	c.SoapVersion = version
+}
+
+// IsSoapV1 returns true when the typed services are dispatched through the
+// SOAP v1 call operation
+func (c *Client) IsSoapV1() bool {
+	return c.SoapVersion == SoapV1
+}
+
+type v1Request struct {
+	XMLName xml.Name
+
+	SessionID *Session
+	Params    Params
+}
+
+func (r *v1Request) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	// the prefixes are used in the xsi:type values, so they have to be
+	// declared explicitly
+	start = xml.StartElement{
+		Name: r.XMLName,
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			{Name: xml.Name{Local: "xmlns:xsd"}, Value: xsdNamespace},
+			{Name: xml.Name{Local: "xmlns:SOAP-ENC"}, Value: soapEncNamespace},
+			{Name: xml.Name{Local: "xmlns:ns2"}, Value: mapNamespace},
+		},
+	}
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	err = encodeV1Value(e, "sessionId", r.SessionID.Token())
+	if err != nil {
+		return err
+	}
+
+	for _, param := range r.Params {
+		err = encodeV1Value(e, param.Name, param.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// operation returns the resource path of call requests
+func (r *v1Request) operation() string {
+	if r.XMLName.Local == callAction && len(r.Params) > 0 {
+		if resourcePath, ok := r.Params[0].Value.(string); ok {
+			return resourcePath
+		}
+	}
+	return r.XMLName.Local
+}
+
+// idempotent reports whether all resource calls of a call or multiCall
+// request are idempotent
+func (r *v1Request) idempotent() bool {
+	if r.XMLName.Local != multiCallAction {
+		return IsIdempotent(r.operation())
+	}
+
+	calls, _ := r.Params[0].Value.([]interface{})
+	for _, call := range calls {
+		c, _ := call.([]interface{})
+		if len(c) == 0 {
+			return false
+		}
+
+		resourcePath, _ := c[0].(string)
+		if !IsIdempotent(resourcePath) {
+			return false
+		}
+	}
+	return true
+}
+
+// v1Response holds the return element (callReturn, multiCallReturn) of a
+// SOAP v1 response
+type v1Response struct {
+	Return Node `xml:",any"`
+}
+
+func xsiType(value string) xml.Attr {
+	return xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: value}
+}
+
+// encodeV1Value encodes a value as a SOAP-ENC value with an xsi:type
+func encodeV1Value(e *xml.Encoder, name string, value interface{}) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	switch v := value.(type) {
+	case nil:
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"})
+		return e.EncodeElement("", start)
+	case map[string]interface{}:
+		return encodeV1Map(e, start, v)
+	case []interface{}:
+		return encodeV1Array(e, start, v)
+	}
+
+	// types with their own marshaller (e.g. Boolean) are encoded like in
+	// SOAP v2
+	if _, ok := value.(xml.Marshaler); !ok {
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.String:
+			start.Attr = append(start.Attr, xsiType("xsd:string"))
+			return e.EncodeElement(rv.String(), start)
+		case reflect.Bool:
+			start.Attr = append(start.Attr, xsiType("xsd:boolean"))
+			return e.EncodeElement(strconv.FormatBool(rv.Bool()), start)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			start.Attr = append(start.Attr, xsiType("xsd:int"))
+			return e.EncodeElement(strconv.FormatInt(rv.Int(), 10), start)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			start.Attr = append(start.Attr, xsiType("xsd:int"))
+			return e.EncodeElement(strconv.FormatUint(rv.Uint(), 10), start)
+		case reflect.Float32, reflect.Float64:
+			start.Attr = append(start.Attr, xsiType("xsd:double"))
+			return e.EncodeElement(strconv.FormatFloat(rv.Float(), 'f', -1, 64), start)
+		case reflect.Ptr, reflect.Interface:
+			if rv.IsNil() {
+				return encodeV1Value(e, name, nil)
+			}
+			return encodeV1Value(e, name, rv.Elem().Interface())
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() != reflect.Uint8 {
+				list := make([]interface{}, rv.Len())
+				for i := range list {
+					list[i] = rv.Index(i).Interface()
+				}
+				return encodeV1Array(e, start, list)
+			}
+		case reflect.Map:
+			if rv.Type().Key().Kind() == reflect.String {
+				m := make(map[string]interface{}, rv.Len())
+				for _, key := range rv.MapKeys() {
+					m[key.String()] = rv.MapIndex(key).Interface()
+				}
+				return encodeV1Map(e, start, m)
+			}
+		}
+	}
+
+	// structs are converted through their SOAP v2 xml representation
+	node, err := marshalNode(value)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return encodeV1Value(e, name, nil)
+	}
+	return encodeV1Value(e, name, node.Value())
+}
+
+func encodeV1Map(e *xml.Encoder, start xml.StartElement, m map[string]interface{}) error {
+	start.Attr = append(start.Attr, xsiType("ns2:Map"))
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		item := xml.StartElement{Name: xml.Name{Local: "item"}}
+		err := e.EncodeToken(item)
+		if err != nil {
+			return err
+		}
+
+		err = encodeV1Value(e, "key", key)
+		if err != nil {
+			return err
+		}
+
+		err = encodeV1Value(e, "value", m[key])
+		if err != nil {
+			return err
+		}
+
+		err = e.EncodeToken(item.End())
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func encodeV1Array(e *xml.Encoder, start xml.StartElement, list []interface{}) error {
+	start.Attr = append(start.Attr,
+		xsiType("SOAP-ENC:Array"),
+		xml.Attr{
+			Name:  xml.Name{Local: "SOAP-ENC:arrayType"},
+			Value: fmt.Sprintf("xsd:anyType[%d]", len(list)),
+		},
+	)
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range list {
+		err := encodeV1Value(e, "item", value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// marshalNode marshals value to xml and parses it back into a Node; it
+// returns nil when value marshals to nothing
+func marshalNode(value interface{}) (*Node, error) {
+	buf := new(bytes.Buffer)
+	e := xml.NewEncoder(buf)
+	err := e.EncodeElement(value, xml.StartElement{Name: xml.Name{Local: "value"}})
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	node := &Node{}
+	err = xml.Unmarshal(buf.Bytes(), node)
+	return node, err
+}
+
+// decodeV1Value converts a SOAP v1 value to a Go value:
+//   - nil for elements with xsi:nil="true"
+//   - map[string]interface{} for ns2:Map values (PHP associative arrays) and
+//     structs
+//   - []interface{} for SOAP-ENC:Array values
+//   - string for other values
+func decodeV1Value(n Node) interface{} {
+	if n.isNil() {
+		return nil
+	}
+
+	typ := ""
+	for _, attr := range n.Attrs {
+		if attr.Name.Space == xsiNamespace && attr.Name.Local == "type" {
+			typ = attr.Value[strings.Index(attr.Value, ":")+1:]
+		}
+	}
+
+	if typ == "Map" {
+		m := make(map[string]interface{}, len(n.Nodes))
+		for _, item := range n.Nodes {
+			var key string
+			var value interface{}
+			for _, child := range item.Nodes {
+				switch child.XMLName.Local {
+				case "key":
+					key = child.Content
+				case "value":
+					value = decodeV1Value(child)
+				}
+			}
+			m[key] = value
+		}
+		return m
+	}
+
+	if typ == "Array" || n.isArray() {
+		list := make([]interface{}, len(n.Nodes))
+		for i, child := range n.Nodes {
+			list[i] = decodeV1Value(child)
+		}
+		return list
+	}
+
+	if len(n.Nodes) > 0 {
+		m := make(map[string]interface{}, len(n.Nodes))
+		for _, child := range n.Nodes {
+			m[child.XMLName.Local] = decodeV1Value(child)
+		}
+		return m
+	}
+
+	return n.Content
+}
+
+func setV1Result(value interface{}, result interface{}) error {
+	switch r := result.(type) {
+	case nil:
+		return nil
+	case *interface{}:
+		*r = value
+		return nil
+	case *map[string]interface{}:
+		m, ok := value.(map[string]interface{})
+		if !ok && value != nil {
+			return fmt.Errorf("Expected a map, got %T", value)
+		}
+		*r = m
+		return nil
+	case *[]interface{}:
+		list, ok := value.([]interface{})
+		if !ok && value != nil {
+			return fmt.Errorf("Expected an array, got %T", value)
+		}
+		*r = list
+		return nil
+	}
+
+	// write the value in the shape of a SOAP v2 response so it can be
+	// decoded with the same xml tags
+	buf := new(bytes.Buffer)
+	e := xml.NewEncoder(buf)
+	err := writeV1Value(e, "callReturn", value)
+	if err != nil {
+		return err
+	}
+
+	err = e.Flush()
+	if err != nil {
+		return err
+	}
+
+	return xml.Unmarshal(buf.Bytes(), result)
+}
+
+// writeV1Value writes maps as elements, arrays as <item> elements and map
+// keys that aren't valid element names as associativeEntity items
+func writeV1Value(e *xml.Encoder, name string, value interface{}) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	switch v := value.(type) {
+	case nil:
+		return e.EncodeElement("", start)
+	case string:
+		return e.EncodeElement(v, start)
+	case []interface{}:
+		err := e.EncodeToken(start)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range v {
+			err := writeV1Value(e, "item", item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return e.EncodeToken(start.End())
+	case map[string]interface{}:
+		err := e.EncodeToken(start)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if isXMLName(key) {
+				err := writeV1Value(e, key, v[key])
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			item := map[string]interface{}{"key": key, "value": v[key]}
+			err := writeV1Value(e, "item", item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return e.EncodeToken(start.End())
+	}
+
+	return fmt.Errorf("Unexpected SOAP v1 value %T", value)
+}
+
+func isXMLName(s string) bool {
+	if s == "" || strings.HasPrefix(strings.ToLower(s), "xml") {
+		return false
+	}
+
+	for i, r := range s {
+		if unicode.IsLetter(r) || r == '_' {
+			continue
+		}
+		if i > 0 && (unicode.IsDigit(r) || r == '-' || r == '.') {
+			continue
+		}
+		return false
+	}
+
+	return true
+}
+
+// v1Filters converts filters to the associative array used by the SOAP v1
+// list methods: {"key": "value", "key": {"operator": "value"}}
+func v1Filters(filters *Filters) interface{} {
+	if filters == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+	for _, filter := range filters.Filter {
+		m[filter.Key] = filter.Value
+	}
+
+	for _, filter := range filters.ComplexFilter {
+		var value interface{} = filter.Value.Value
+		switch filter.Value.Key {
+		case "in", "nin", "finset":
+			// SOAP v2 takes a comma separated list, SOAP v1 an array
+			list := []interface{}{}
+			for _, v := range strings.Split(filter.Value.Value, ",") {
+				list = append(list, strings.TrimSpace(v))
+			}
+			value = list
+		}
+
+		conditions, ok := m[filter.Key].(map[string]interface{})
+		if !ok {
+			conditions = map[string]interface{}{}
+			m[filter.Key] = conditions
+		}
+		conditions[filter.Value.Key] = value
+	}
+
+	return m
+}
+
+// v1Optional returns nil for empty strings so the default of the resource
+// method is used
+func v1Optional(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
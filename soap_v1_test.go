@@ -0,0 +1,111 @@
+package magento
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+// decodeV1Response decodes a captured SOAP v1 response like Client.doV1()
+func decodeV1Response(t *testing.T, body string) interface{} {
+	t.Helper()
+
+	responseBody := &v1Response{}
+	response := NewResponse().WithData(responseBody)
+	err := xml.Unmarshal([]byte(body), NewClient(nil).responseEnvelope(response))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decodeV1Value(responseBody.Return)
+}
+
+func TestV1CallResponse(t *testing.T) {
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:ns2="http://xml.apache.org/xml-soap" xmlns:SOAP-ENC="http://schemas.xmlsoap.org/soap/encoding/" SOAP-ENV:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/"><SOAP-ENV:Body><ns1:callResponse><callReturn xsi:type="ns2:Map"><item><key xsi:type="xsd:string">product_id</key><value xsi:type="xsd:string">1</value></item><item><key xsi:type="xsd:string">sku</key><value xsi:type="xsd:string">abc</value></item><item><key xsi:type="xsd:string">category_ids</key><value SOAP-ENC:arrayType="xsd:string[2]" xsi:type="SOAP-ENC:Array"><item xsi:type="xsd:string">3</item><item xsi:type="xsd:string">4</item></value></item><item><key xsi:type="xsd:string">website_ids</key><value SOAP-ENC:arrayType="xsd:ur-type[0]" xsi:type="SOAP-ENC:Array"/></item><item><key xsi:type="xsd:string">stock_data</key><value xsi:type="ns2:Map"><item><key xsi:type="xsd:string">qty</key><value xsi:type="xsd:string">5</value></item><item><key xsi:type="xsd:string">is_in_stock</key><value xsi:type="xsd:string">1</value></item></value></item><item><key xsi:type="xsd:string">special_price</key><value xsi:nil="true"/></item></callReturn></ns1:callResponse></SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+	value := decodeV1Response(t, body)
+	want := map[string]interface{}{
+		"product_id":   "1",
+		"sku":          "abc",
+		"category_ids": []interface{}{"3", "4"},
+		"website_ids":  []interface{}{},
+		"stock_data": map[string]interface{}{
+			"qty":         "5",
+			"is_in_stock": "1",
+		},
+		"special_price": nil,
+	}
+	if !reflect.DeepEqual(value, want) {
+		t.Errorf("got %#v, want %#v", value, want)
+	}
+
+	product := &CatalogProductReturnEntity{}
+	err := setV1Result(value, product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.ProductID != "1" || product.Sku != "abc" || product.SpecialPrice != "" {
+		t.Errorf("got %+v", product)
+	}
+}
+
+func TestV1MultiCallFault(t *testing.T) {
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:ns2="http://xml.apache.org/xml-soap" xmlns:SOAP-ENC="http://schemas.xmlsoap.org/soap/encoding/" SOAP-ENV:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/"><SOAP-ENV:Body><ns1:multiCallResponse><multiCallReturn SOAP-ENC:arrayType="xsd:ur-type[2]" xsi:type="SOAP-ENC:Array"><item xsi:type="ns2:Map"><item><key xsi:type="xsd:string">product_id</key><value xsi:type="xsd:string">1</value></item></item><item xsi:type="ns2:Map"><item><key xsi:type="xsd:string">isFault</key><value xsi:type="xsd:boolean">true</value></item><item><key xsi:type="xsd:string">faultCode</key><value xsi:type="xsd:string">101</value></item><item><key xsi:type="xsd:string">faultMessage</key><value xsi:type="xsd:string">Product not exists.</value></item></item></multiCallReturn></ns1:multiCallResponse></SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+	results, ok := decodeV1Response(t, body).([]interface{})
+	if !ok || len(results) != 2 {
+		t.Fatalf("got %#v, want 2 results", results)
+	}
+
+	if fault := multiCallFault(results[0]); fault != nil {
+		t.Errorf("result 0: unexpected fault %s", fault)
+	}
+
+	fault := multiCallFault(results[1])
+	if fault == nil {
+		t.Fatal("result 1: expected a fault")
+	}
+	if fault.Code != "101" || fault.Message != "Product not exists." {
+		t.Errorf("got fault %+v", fault)
+	}
+}
+
+func TestV1RoundTrip(t *testing.T) {
+	args := []interface{}{
+		"abc",
+		map[string]interface{}{
+			"name":  "Abc",
+			"stock": map[string]interface{}{"qty": "5"},
+			"tags":  []interface{}{},
+		},
+		nil,
+	}
+
+	requestBody := &v1Request{
+		XMLName:   xml.Name{Space: xmlns, Local: callAction},
+		SessionID: &Session{},
+		Params:    Params{{"args", args}},
+	}
+	data, err := xml.Marshal(requestBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node := Node{}
+	err = xml.Unmarshal(data, &node)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var value interface{}
+	for _, child := range node.Nodes {
+		if child.XMLName.Local == "args" {
+			value = decodeV1Value(child)
+		}
+	}
+
+	if !reflect.DeepEqual(value, args) {
+		t.Errorf("got %#v, want %#v\n%s", value, args, data)
+	}
+}
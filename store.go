@@ -0,0 +1,240 @@
+package magento
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// the admin store, which storeList leaves out
+	adminStoreCode = "admin"
+	adminStoreID   = 0
+
+	storeListAction   = "storeList"
+	storeInfoAction   = "storeInfo"
+	magentoInfoAction = "magentoInfo"
+
+	// SOAP v1 resource path
+	storeListResource = "store.list"
+)
+
+func NewStoreService(client *Client) *StoreService {
+	return &StoreService{Client: client}
+}
+
+type StoreService struct {
+	Client *Client
+}
+
+func (s *StoreService) List(ctx context.Context, requestBody *StoreListRequest) (*StoreListResponse, error) {
+	responseBody := NewStoreListResponse()
+
+	// used by CheckStoreView(), so it's also available in SOAP v1 mode
+	if s.Client.IsSoapV1() {
+		err := s.Client.CallV1(ctx, storeListResource, nil, &responseBody.Stores)
+		return responseBody, err
+	}
+
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewStoreListRequest() *StoreListRequest {
+	return &StoreListRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: storeListAction,
+		},
+	}
+}
+
+type StoreListRequest struct {
+	XMLName xml.Name `xml:"storeList"`
+
+	SessionID *Session
+}
+
+func NewStoreListResponse() *StoreListResponse {
+	return &StoreListResponse{}
+}
+
+type StoreListResponse struct {
+	Stores StoreEntityArray `xml:"stores"`
+}
+
+type StoreEntityArray struct {
+	Items []StoreEntity `xml:"item"`
+}
+
+type StoreEntity struct {
+	StoreID   int     `xml:"store_id"`
+	Code      string  `xml:"code"`
+	WebsiteID int     `xml:"website_id"`
+	GroupID   int     `xml:"group_id"`
+	Name      string  `xml:"name"`
+	SortOrder int     `xml:"sort_order"`
+	IsActive  Boolean `xml:"is_active"`
+}
+
+func (s *StoreService) Info(ctx context.Context, requestBody *StoreInfoRequest) (*StoreInfoResponse, error) {
+	responseBody := NewStoreInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewStoreInfoRequest() *StoreInfoRequest {
+	return &StoreInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: storeInfoAction,
+		},
+	}
+}
+
+type StoreInfoRequest struct {
+	XMLName xml.Name `xml:"storeInfo"`
+
+	SessionID *Session
+	// Store ID or code
+	StoreID string `xml:"storeId"`
+}
+
+func NewStoreInfoResponse() *StoreInfoResponse {
+	return &StoreInfoResponse{}
+}
+
+type StoreInfoResponse struct {
+	Info StoreEntity `xml:"info"`
+}
+
+func (s *StoreService) MagentoInfo(ctx context.Context, requestBody *MagentoInfoRequest) (*MagentoInfoResponse, error) {
+	responseBody := NewMagentoInfoResponse()
+	response := NewResponse().WithData(responseBody)
+	session, err := s.Client.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody.SessionID = session
+	request := NewRequest().WithData(requestBody)
+
+	// create a new HTTP request
+	httpReq, err := s.Client.NewRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// submit the request
+	_, err = s.Client.Do(httpReq, response)
+	return responseBody, err
+}
+
+func NewMagentoInfoRequest() *MagentoInfoRequest {
+	return &MagentoInfoRequest{
+		XMLName: xml.Name{
+			Space: xmlns,
+			Local: magentoInfoAction,
+		},
+	}
+}
+
+type MagentoInfoRequest struct {
+	XMLName xml.Name `xml:"magentoInfo"`
+
+	SessionID *Session
+}
+
+func NewMagentoInfoResponse() *MagentoInfoResponse {
+	return &MagentoInfoResponse{}
+}
+
+type MagentoInfoResponse struct {
+	Info MagentoInfoEntity `xml:"info"`
+}
+
+type MagentoInfoEntity struct {
+	// "Community" or "Enterprise"
+	MagentoEdition string `xml:"magento_edition"`
+	MagentoVersion string `xml:"magento_version"`
+}
+
+// StoreID resolves a store code like "default" to its ID. Numeric values are
+// checked against the store list. The store list is fetched once and cached on
+// the client. The admin store ("admin" or "0", used for global attribute
+// values) isn't in the store list and is always accepted.
+func (c *Client) StoreID(ctx context.Context, code string) (int, error) {
+	if code == adminStoreCode || code == strconv.Itoa(adminStoreID) {
+		return adminStoreID, nil
+	}
+
+	c.storesMutex.Lock()
+	defer c.storesMutex.Unlock()
+
+	if c.stores == nil {
+		resp, err := c.Store.List(ctx, NewStoreListRequest())
+		if err != nil {
+			return 0, err
+		}
+
+		c.stores = make(map[string]int, len(resp.Stores.Items)*2)
+		for _, store := range resp.Stores.Items {
+			c.stores[store.Code] = store.StoreID
+			c.stores[strconv.Itoa(store.StoreID)] = store.StoreID
+		}
+	}
+
+	if id, ok := c.stores[code]; ok {
+		return id, nil
+	}
+
+	return 0, fmt.Errorf("Unknown store view \"%s\"", code)
+}
+
+func (c *Client) ClearStoreCache() {
+	c.storesMutex.Lock()
+	defer c.storesMutex.Unlock()
+	c.stores = nil
+}
+
+// CheckStoreView returns an error when ValidateStoreView is enabled and
+// storeView isn't an existing store code or ID. An empty storeView (the
+// default store) is always valid.
+func (c *Client) CheckStoreView(ctx context.Context, storeView string) error {
+	if c.ValidateStoreView == false || storeView == "" {
+		return nil
+	}
+
+	_, err := c.StoreID(ctx, storeView)
+	return err
+}
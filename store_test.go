@@ -0,0 +1,35 @@
+package magento
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCheckStoreView(t *testing.T) {
+	c := NewClient(nil)
+	c.SetValidateStoreView(true)
+	c.stores = map[string]int{"default": 1, "1": 1}
+
+	tests := []struct {
+		storeView string
+		valid     bool
+	}{
+		{"", true},
+		{"default", true},
+		{"1", true},
+		{"admin", true},
+		{"0", true},
+		{"nl", false},
+		{"2", false},
+	}
+
+	for _, test := range tests {
+		err := c.CheckStoreView(context.Background(), test.storeView)
+		if test.valid && err != nil {
+			t.Errorf("%q: %s", test.storeView, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%q: expected an error", test.storeView)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package magento
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrStopStream can be returned by the function passed to a ListStream()
+// method to stop reading the list; ListStream() then returns nil
+var ErrStopStream = errors.New("magento: stop stream")
+
+// Depth of the elements in a SOAP response:
+//
+//	<Envelope>                               1
+//	   <Body>                                2
+//	      <catalogProductListResponse>       3 (or <Fault>)
+//	         <storeView>                     4
+//	            <item>...</item>             5
+const (
+	streamBodyDepth     = 2
+	streamResponseDepth = 3
+	streamItemDepth     = 5
+)
+
+// WithStream sets a function that is called with every item element of the
+// result while the response body is read, so large lists don't have to be
+// kept in memory. The body isn't decoded into the envelope then.
+//
+// A request with a streamed response isn't retried once an item has been
+// passed to fn.
+func (r *Response) WithStream(fn func(d *xml.Decoder, start xml.StartElement) error) *Response {
+	r.stream = fn
+	return r
+}
+
+// streamItems returns a stream function that decodes every item into a T
+func streamItems[T any](fn func(T) error) func(d *xml.Decoder, start xml.StartElement) error {
+	return func(d *xml.Decoder, start xml.StartElement) error {
+		var item T
+		err := d.DecodeElement(&item, &start)
+		if err != nil {
+			return err
+		}
+		return fn(item)
+	}
+}
+
+// forEachItem passes the items of a list that has been read completely to fn
+func forEachItem[T any](items []T, fn func(T) error) error {
+	for _, item := range items {
+		err := fn(item)
+		if errors.Is(err, ErrStopStream) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeStream reads the response body and passes the item elements to the
+// stream function of the response. Faults are detected from the first
+// element in the body. It returns the start of the body for logging.
+func (c *Client) decodeStream(httpResp *http.Response, response *Response) ([]byte, error) {
+	body := &captureReader{r: httpResp.Body}
+	if logger, options := c.requestLogger(); logger != nil && options.LogBodies {
+		body.limit = logBodyWindow(options)
+	}
+
+	// the body has been read (as far as needed) and closed afterwards
+	defer func() {
+		httpResp.Body.Close()
+		httpResp.Body = http.NoBody
+		httpResp.ContentLength = body.n
+	}()
+
+	errorResponse := &ErrorResponse{Response: httpResp}
+	err := checkContentType(httpResp)
+	if err != nil {
+		// read the start of the body for logging
+		io.Copy(io.Discard, io.LimitReader(body, int64(body.limit)))
+		errorResponse.Message = err.Error()
+		return body.data, errorResponse
+	}
+
+	var tokens xml.TokenReader = xml.NewDecoder(body)
+	if c.WSICompliance && !c.IsSoapV1() {
+		tokens = wsiTokenReader{tokens}
+	}
+	d := xml.NewTokenDecoder(tokens)
+
+	err = walkStream(d, response, errorResponse)
+	if errors.Is(err, ErrStopStream) {
+		err = nil
+	}
+	return body.data, err
+}
+
+// walkStream walks the elements of the response up to the items
+func walkStream(d *xml.Decoder, response *Response, errorResponse *ErrorResponse) error {
+	found := false
+	depth := 0
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if _, ok := err.(*xml.SyntaxError); ok {
+			errorResponse.Message = fmt.Sprintf("Malformed xml response")
+			return errorResponse
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+
+			switch {
+			case depth == streamBodyDepth && t.Name.Local == "Header":
+				err = d.Skip()
+				depth--
+			case depth == streamResponseDepth && t.Name.Local == "Fault":
+				fault := struct {
+					Code    string `xml:"faultcode"`
+					Message string `xml:"faultstring"`
+					Reason  string `xml:"Reason>Text"`
+				}{}
+				err = d.DecodeElement(&fault, &t)
+				if err == nil {
+					errorResponse.Code = fault.Code
+					errorResponse.Message = fault.Message
+					errorResponse.Reason = fault.Reason
+					return errorResponse
+				}
+			case depth == streamResponseDepth:
+				found = true
+			case depth == streamItemDepth && t.Name.Local == "item":
+				response.streamed++
+				err = response.stream(d, t)
+				depth--
+			case depth == streamItemDepth:
+				err = d.Skip()
+				depth--
+			}
+
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if !found {
+		errorResponse.Message = fmt.Sprintf("Malformed xml response")
+		return errorResponse
+	}
+
+	if code := errorResponse.Response.StatusCode; code < 200 || code > 299 {
+		errorResponse.Message = fmt.Sprintf("Unexpected status %s", errorResponse.Response.Status)
+		return errorResponse
+	}
+
+	return nil
+}
+
+// captureReader counts the bytes that are read and keeps the first limit
+// bytes
+type captureReader struct {
+	r     io.Reader
+	n     int64
+	limit int
+	data  []byte
+}
+
+func (r *captureReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if keep := r.limit - len(r.data); keep > 0 {
+		if keep > n {
+			keep = n
+		}
+		r.data = append(r.data, p[:keep]...)
+	}
+	return n, err
+}
@@ -0,0 +1,156 @@
+package magento
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket: it allows rate requests per second with
+// bursts of up to burst requests
+type RateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long to wait until it's available
+func (l *RateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that wasn't used
+func (l *RateLimiter) cancel() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.tokens++
+}
+
+// ThrottleStats reports how often and how long requests waited for the rate
+// limit or the maximum number of requests in flight
+type ThrottleStats struct {
+	Requests  int
+	Throttled int
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// SetRateLimit limits the requests to rate per second with bursts of up to
+// burst requests; a rate of 0 disables the limit
+func (c *Client) SetRateLimit(rate float64, burst int) {
+	if rate <= 0 {
+		c.rateLimiter = nil
+		return
+	}
+	c.rateLimiter = NewRateLimiter(rate, burst)
+}
+
+// SetMaxInFlight limits the number of concurrent requests; 0 disables the
+// limit. It shouldn't be changed while requests are running.
+func (c *Client) SetMaxInFlight(max int) {
+	if max <= 0 {
+		c.inFlight = nil
+		return
+	}
+	c.inFlight = make(chan struct{}, max)
+}
+
+func (c *Client) ThrottleStats() ThrottleStats {
+	c.throttleMutex.Lock()
+	defer c.throttleMutex.Unlock()
+	return c.throttleStats
+}
+
+// acquire waits for the rate limit and a free slot. The returned function
+// releases the slot.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	release := func() {}
+
+	if c.rateLimiter != nil {
+		err := c.rateLimiter.Wait(ctx)
+		if err != nil {
+			c.recordWait(time.Since(start))
+			return nil, err
+		}
+	}
+
+	if inFlight := c.inFlight; inFlight != nil {
+		select {
+		case inFlight <- struct{}{}:
+			release = func() { <-inFlight }
+		case <-ctx.Done():
+			c.recordWait(time.Since(start))
+			return nil, ctx.Err()
+		}
+	}
+
+	c.recordWait(time.Since(start))
+	return release, nil
+}
+
+func (c *Client) recordWait(wait time.Duration) {
+	c.throttleMutex.Lock()
+	defer c.throttleMutex.Unlock()
+
+	c.throttleStats.Requests++
+
+	// ignore the time spent on taking an available token or slot
+	if wait < time.Millisecond {
+		return
+	}
+
+	c.throttleStats.Throttled++
+	c.throttleStats.TotalWait += wait
+	if wait > c.throttleStats.MaxWait {
+		c.throttleStats.MaxWait = wait
+	}
+}
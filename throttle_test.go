@@ -0,0 +1,148 @@
+package magento
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterRefill(t *testing.T) {
+	l := NewRateLimiter(10, 2)
+
+	// the burst is available at once
+	for i := 0; i < 2; i++ {
+		if delay := l.reserve(); delay != 0 {
+			t.Fatalf("request %d: got delay %s, want 0", i+1, delay)
+		}
+	}
+
+	// the next token is available after 1/rate
+	delay := l.reserve()
+	if delay < 90*time.Millisecond || delay > 100*time.Millisecond {
+		t.Errorf("got delay %s, want 100ms", delay)
+	}
+	l.cancel()
+
+	// the bucket refills at rate per second up to the burst
+	l.mutex.Lock()
+	l.last = l.last.Add(-time.Second)
+	l.mutex.Unlock()
+	for i := 0; i < 2; i++ {
+		if delay := l.reserve(); delay != 0 {
+			t.Errorf("request %d after refilling: got delay %s, want 0", i+1, delay)
+		}
+	}
+	if delay := l.reserve(); delay == 0 {
+		t.Error("the bucket is refilled beyond the burst")
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l := NewRateLimiter(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		err := l.Wait(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the second and third request wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("3 requests at 20/s took %s, want at least 100ms", elapsed)
+	}
+}
+
+func TestMaxInFlight(t *testing.T) {
+	c := NewClient(nil)
+	c.SetMaxInFlight(2)
+
+	var mutex sync.Mutex
+	running, max := 0, 0
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := c.acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			mutex.Lock()
+			running++
+			if running > max {
+				max = running
+			}
+			mutex.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if max != 2 {
+		t.Errorf("got %d requests in flight at once, want 2", max)
+	}
+
+	stats := c.ThrottleStats()
+	if stats.Requests != 10 {
+		t.Errorf("got %d requests, want 10", stats.Requests)
+	}
+	if stats.Throttled == 0 || stats.TotalWait < 5*time.Millisecond || stats.MaxWait == 0 || stats.MaxWait > stats.TotalWait {
+		t.Errorf("got %+v, want throttled requests", stats)
+	}
+}
+
+func TestAcquireCanceled(t *testing.T) {
+	c := NewClient(nil)
+	c.SetMaxInFlight(1)
+
+	release, err := c.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.acquire(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("waiting for a slot: got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	stats := c.ThrottleStats()
+	if stats.Throttled != 1 || stats.MaxWait < 20*time.Millisecond {
+		t.Errorf("got %+v, want one throttled request of at least 20ms", stats)
+	}
+
+	c = NewClient(nil)
+	c.SetRateLimit(1, 1)
+	_, err = c.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = c.acquire(ctx)
+	if err != context.Canceled {
+		t.Errorf("waiting for the rate limit: got %v, want %v", err, context.Canceled)
+	}
+
+	// the token of the canceled request is returned
+	c.rateLimiter.mutex.Lock()
+	tokens := c.rateLimiter.tokens
+	c.rateLimiter.mutex.Unlock()
+	if tokens < -0.1 {
+		t.Errorf("got %.2f tokens, want the token of the canceled request back", tokens)
+	}
+}
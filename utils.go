@@ -5,10 +5,20 @@ import (
 	"time"
 )
 
+const timeWithoutTimeZoneLayout = "2006-01-02 15:04:05"
+
 type TimeWithoutTimeZone struct {
 	time.Time
 }
 
+// MarshalXML omits the element when the time is not set
+func (t TimeWithoutTimeZone) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if t.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(t.Format(timeWithoutTimeZoneLayout), start)
+}
+
 func (t *TimeWithoutTimeZone) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	var value string
 	err := d.DecodeElement(&value, &start)
@@ -20,10 +30,44 @@ func (t *TimeWithoutTimeZone) UnmarshalXML(d *xml.Decoder, start xml.StartElemen
 		return nil
 	}
 
-	layout := "2006-01-02 15:04:05"
-	t2, err := time.Parse(layout, value)
+	t2, err := time.Parse(timeWithoutTimeZoneLayout, value)
 	if err == nil {
 		*t = TimeWithoutTimeZone{Time: t2}
 	}
 	return err
 }
+
+// Boolean is marshalled as "1" or "0": Magento casts any non-empty string
+// (including "false") to true
+type Boolean bool
+
+// NewBoolean returns a pointer to b, for optional fields that are only sent
+// when they are set
+func NewBoolean(b bool) *Boolean {
+	v := Boolean(b)
+	return &v
+}
+
+func (b Boolean) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	value := "0"
+	if b {
+		value = "1"
+	}
+	return e.EncodeElement(value, start)
+}
+
+func (b *Boolean) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	err := d.DecodeElement(&value, &start)
+	if err != nil {
+		return err
+	}
+
+	switch value {
+	case "", "0", "false":
+		*b = false
+	default:
+		*b = true
+	}
+	return nil
+}
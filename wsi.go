@@ -0,0 +1,246 @@
+package magento
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// In WS-I compliance mode Magento uses document/literal messages:
+//
+//	<catalogProductListRequestParam>
+//	   <sessionId>...</sessionId>
+//	   <filters>
+//	      <filter>
+//	         <complexObjectArray><key>...</key><value>...</value></complexObjectArray>
+//	      </filter>
+//	   </filters>
+//	</catalogProductListRequestParam>
+//
+//	<catalogProductListResponseParam>
+//	   <result>
+//	      <complexObjectArray>...</complexObjectArray>
+//	   </result>
+//	</catalogProductListResponseParam>
+//
+// The requests and responses are rewritten between that format and the
+// default (RPC) format, so the same structs can be used for both.
+
+const (
+	wsiArrayItem     = "complexObjectArray"
+	wsiResult        = "result"
+	wsiRequestParam  = "RequestParam"
+	wsiResponseParam = "ResponseParam"
+)
+
+// Operations whose request element doesn't follow the <operation>RequestParam
+// pattern
+var wsiRequestElements = map[string]string{
+	loginAction: "loginParam",
+}
+
+// envelope returns the envelope of the request in the format of the endpoint
+func (c *Client) envelope(request *Request) *Envelope {
+	if !c.WSICompliance || c.IsSoapV1() || request.Envelope.Body.Data == nil {
+		return request.Envelope
+	}
+
+	envelope := *request.Envelope
+	envelope.Body = &Body{Data: &wsiRequest{data: request.Envelope.Body.Data}}
+	return &envelope
+}
+
+// responseEnvelope returns the envelope the response is decoded into
+func (c *Client) responseEnvelope(response *Response) *Envelope {
+	if !c.WSICompliance || c.IsSoapV1() || response.Envelope.Body.Data == nil {
+		return response.Envelope
+	}
+
+	envelope := *response.Envelope
+	envelope.Body = &Body{Data: &wsiResponse{data: response.Envelope.Body.Data}}
+	return &envelope
+}
+
+type wsiRequest struct {
+	data interface{}
+}
+
+// MarshalXML marshals the request data and renames the operation element and
+// the array items
+func (r *wsiRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	data, err := xml.Marshal(r.data)
+	if err != nil {
+		return err
+	}
+
+	return copyTokens(e, xml.NewDecoder(bytes.NewReader(data)), "", func(name string, depth int) string {
+		if depth == 1 {
+			if element, ok := wsiRequestElements[name]; ok {
+				return element
+			}
+			return name + wsiRequestParam
+		}
+		if name == "item" {
+			return wsiArrayItem
+		}
+		return name
+	})
+}
+
+type wsiResponse struct {
+	data interface{}
+}
+
+// UnmarshalXML renames the response element, the result element and the
+// array items back to the names the response data expects and unmarshals it
+func (r *wsiResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	result := wsiResultName(r.data)
+
+	buf := new(bytes.Buffer)
+	e := xml.NewEncoder(buf)
+
+	start.Name.Local = strings.TrimSuffix(start.Name.Local, wsiResponseParam) + "Response"
+	err := e.EncodeToken(cleanStartElement(start))
+	if err != nil {
+		return err
+	}
+
+	err = copyTokens(e, d, start.Name.Space, func(name string, depth int) string {
+		if depth == 1 && name == wsiResult {
+			return result
+		}
+		if name == wsiArrayItem {
+			return "item"
+		}
+		return name
+	})
+	if err != nil {
+		return err
+	}
+
+	err = e.EncodeToken(start.End())
+	if err != nil {
+		return err
+	}
+
+	err = e.Flush()
+	if err != nil {
+		return err
+	}
+
+	return xml.Unmarshal(buf.Bytes(), r.data)
+}
+
+// wsiResultName returns the element name of the first field of the response
+// data, e.g. "storeView" for CatalogProductListResponse
+func wsiResultName(data interface{}) string {
+	t := reflect.TypeOf(data)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return wsiResult
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "XMLName" {
+			continue
+		}
+
+		tag := field.Tag.Get("xml")
+		name := strings.Split(strings.Split(tag, ",")[0], ">")[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return wsiResult
+}
+
+// copyTokens copies the tokens of d to e until the end of the element d is
+// in (or the end of the document), renaming elements with rename. depth is 1
+// for the outermost elements. space is the namespace of the element d is in.
+func copyTokens(e *xml.Encoder, d *xml.Decoder, space string, rename func(name string, depth int) string) error {
+	// the names of the open elements, to close them with the same name, and
+	// their namespaces
+	names := []xml.Name{}
+	spaces := []string{space}
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			t = cleanStartElement(t.Copy())
+			spaces = append(spaces, t.Name.Space)
+			if t.Name.Space == spaces[len(spaces)-2] {
+				// inherited from the parent element
+				t.Name.Space = ""
+			}
+			t.Name.Local = rename(t.Name.Local, len(names)+1)
+			names = append(names, t.Name)
+			token = t
+		case xml.EndElement:
+			if len(names) == 0 {
+				// end of the element the decoder was in
+				return nil
+			}
+			token = xml.EndElement{Name: names[len(names)-1]}
+			names = names[:len(names)-1]
+			spaces = spaces[:len(spaces)-1]
+		case xml.ProcInst:
+			// the xml declaration can't be written inside an element
+			continue
+		default:
+			token = xml.CopyToken(token)
+		}
+
+		err = e.EncodeToken(token)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// cleanStartElement drops the namespace declarations of a decoded element;
+// the encoder declares the namespaces that are used again
+func cleanStartElement(start xml.StartElement) xml.StartElement {
+	attrs := make([]xml.Attr, 0, len(start.Attr))
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	start.Attr = attrs
+	return start
+}
+
+// wsiTokenReader renames the array items of a streamed WS-I response
+type wsiTokenReader struct {
+	r xml.TokenReader
+}
+
+func (t wsiTokenReader) Token() (xml.Token, error) {
+	token, err := t.r.Token()
+	switch tok := token.(type) {
+	case xml.StartElement:
+		if tok.Name.Local == wsiArrayItem {
+			tok.Name.Local = "item"
+			token = tok
+		}
+	case xml.EndElement:
+		if tok.Name.Local == wsiArrayItem {
+			tok.Name.Local = "item"
+			token = tok
+		}
+	}
+	return token, err
+}
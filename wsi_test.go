@@ -0,0 +1,112 @@
+package magento
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newWSIClient() *Client {
+	u, _ := url.Parse("https://shop.example.com/index.php/api/v2_soap/index/")
+	return NewClient(u, WithWSICompliance(true))
+}
+
+func TestWSIRequest(t *testing.T) {
+	c := newWSIClient()
+
+	tests := []struct {
+		name string
+		data interface{}
+		want []string
+	}{
+		{
+			name: "login",
+			data: NewLoginRequest().WithApiUser("user").WithApiKey("key"),
+			want: []string{"<loginParam>", "<username>user</username>", "</loginParam>"},
+		},
+		{
+			name: "list with filters",
+			data: &CatalogProductListRequest{
+				XMLName:   xml.Name{Space: xmlns, Local: catalogProductListAction},
+				Filters:   NewFilters().Add("sku", "abc").AddComplex("product_id", "gt", "10"),
+				StoreView: "default",
+			},
+			want: []string{
+				"<catalogProductListRequestParam>",
+				"<filter><complexObjectArray><key>sku</key><value>abc</value></complexObjectArray></filter>",
+				"<complex_filter><complexObjectArray><key>product_id</key><value><key>gt</key><value>10</value></value></complexObjectArray></complex_filter>",
+				"<storeView>default</storeView>",
+				"</catalogProductListRequestParam>",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		data, err := xml.Marshal(c.envelope(NewRequest().WithData(test.data)))
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+
+		body := string(data)
+		for _, want := range test.want {
+			if !strings.Contains(body, want) {
+				t.Errorf("%s: %s doesn't contain %s", test.name, body, want)
+			}
+		}
+		if strings.Contains(body, "<item>") {
+			t.Errorf("%s: %s contains <item>", test.name, body)
+		}
+	}
+}
+
+func TestWSIResponse(t *testing.T) {
+	c := newWSIClient()
+
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento">
+<SOAP-ENV:Body>
+<ns1:catalogProductListResponseParam>
+<result>
+<complexObjectArray><product_id>1</product_id><sku>a</sku><category_ids><complexObjectArray>3</complexObjectArray><complexObjectArray>4</complexObjectArray></category_ids></complexObjectArray>
+<complexObjectArray><product_id>2</product_id><sku>b</sku></complexObjectArray>
+</result>
+</ns1:catalogProductListResponseParam>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+	responseBody := NewCatalogProductListResponse()
+	response := NewResponse().WithData(responseBody)
+	err := xml.Unmarshal([]byte(body), c.responseEnvelope(response))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := responseBody.StoreView.Items
+	if len(items) != 2 {
+		t.Fatalf("got %d items, want 2", len(items))
+	}
+	if items[0].ProductID != 1 || items[0].Sku != "a" || items[1].Sku != "b" {
+		t.Errorf("got %+v", items)
+	}
+	if len(items[0].CategoryIDs) != 2 || items[0].CategoryIDs[1] != 4 {
+		t.Errorf("got category ids %v, want [3 4]", items[0].CategoryIDs)
+	}
+}
+
+func TestWSILoginResponse(t *testing.T) {
+	c := newWSIClient()
+
+	body := `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ns1="urn:Magento">
+<SOAP-ENV:Body><ns1:loginResponseParam><result>abc123</result></ns1:loginResponseParam></SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+	responseBody := NewLoginResponse()
+	err := xml.Unmarshal([]byte(body), c.responseEnvelope(NewResponse().WithData(responseBody)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if responseBody.LoginReturn != "abc123" {
+		t.Errorf("got session %q, want abc123", responseBody.LoginReturn)
+	}
+}